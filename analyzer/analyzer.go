@@ -0,0 +1,432 @@
+// Package analyzer provides a go/analysis analyzer that reports direct
+// dereferences of optional pointer fields, which panic when the field is
+// unset, and suggests ptr.From or ptr.FromOr instead.
+//
+// A field is considered optional when it has a pointer type and its struct
+// tag carries the omitempty or omitzero option for any key, as in
+// `json:"email,omitempty"`. A dereference is accepted when it is guarded by
+// a nil check: inside `if p != nil { ... }`, on the right of `p != nil &&`
+// or `p == nil ||`, or after an early `if p == nil { return }`, where p must
+// denote the same variable or field as the dereferenced pointer. Writes
+// such as `*p = v` and `(*p).City = v` panic just the same and are
+// reported, but without a suggested fix, since ptr.From returns a copy.
+//
+// Run it with go vet through the ptrvet command:
+//
+//	go install go.companyinfo.dev/ptr/analyzer/cmd/ptrvet@latest
+//	go vet -vettool=$(which ptrvet) ./...
+package analyzer
+
+import (
+	"bytes"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// ptrPath is the import path of package ptr, used for suggested fixes.
+const ptrPath = "go.companyinfo.dev/ptr"
+
+// Analyzer reports unguarded dereferences of optional pointer fields.
+var Analyzer = &analysis.Analyzer{
+	Name:     "ptrderef",
+	Doc:      "report dereferences of optional pointer fields that may be nil\n\nFields of pointer type tagged omitempty or omitzero may be unset. Dereferencing them without a nil check panics; use ptr.From or ptr.FromOr instead.",
+	URL:      "https://pkg.go.dev/go.companyinfo.dev/ptr/analyzer",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.WithStack([]ast.Node{(*ast.StarExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		star := n.(*ast.StarExpr)
+		if tv, ok := pass.TypesInfo.Types[star]; !ok || tv.IsType() {
+			return true
+		}
+		name, ok := optionalField(pass, star.X)
+		if !ok {
+			return true
+		}
+		if guarded(pass.TypesInfo, star.X, stack) {
+			return true
+		}
+
+		// ptr.From returns a copy, which cannot stand in for an addressed
+		// operand such as *u.Email = v or (*u.Addr).City = v.
+		if addressed(pass.TypesInfo, stack) {
+			pass.Report(analysis.Diagnostic{
+				Pos:     star.Pos(),
+				End:     star.End(),
+				Message: "dereference of optional field " + name + " may panic; check it for nil first",
+			})
+			return true
+		}
+		d := analysis.Diagnostic{
+			Pos:     star.Pos(),
+			End:     star.End(),
+			Message: "dereference of optional field " + name + " may panic; use ptr.From or ptr.FromOr",
+		}
+		if pkg, ok := ptrImportName(stack); ok {
+			// The call is a primary expression, so parentheses around the
+			// dereference are replaced too.
+			var target ast.Node = star
+			for i := len(stack) - 2; i >= 0; i-- {
+				p, ok := stack[i].(*ast.ParenExpr)
+				if !ok {
+					break
+				}
+				target = p
+			}
+			var buf bytes.Buffer
+			if err := format.Node(&buf, pass.Fset, star.X); err == nil {
+				d.SuggestedFixes = []analysis.SuggestedFix{{
+					Message: "Replace with " + pkg + ".From",
+					TextEdits: []analysis.TextEdit{{
+						Pos:     target.Pos(),
+						End:     target.End(),
+						NewText: []byte(pkg + ".From(" + buf.String() + ")"),
+					}},
+				}}
+			}
+		}
+		pass.Report(d)
+		return true
+	})
+	return nil, nil
+}
+
+// optionalField reports whether x selects a pointer field tagged omitempty
+// or omitzero, and returns the field name.
+func optionalField(pass *analysis.Pass, x ast.Expr) (string, bool) {
+	sel, ok := ast.Unparen(x).(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	s, ok := pass.TypesInfo.Selections[sel]
+	if !ok || s.Kind() != types.FieldVal {
+		return "", false
+	}
+	if _, ok := s.Obj().Type().Underlying().(*types.Pointer); !ok {
+		return "", false
+	}
+
+	// Follow the index path through embedded fields to the struct that
+	// declares the field, whose tag we need.
+	t := s.Recv()
+	index := s.Index()
+	for i, idx := range index {
+		if p, ok := t.Underlying().(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return "", false
+		}
+		if i == len(index)-1 {
+			return s.Obj().Name(), isOptionalTag(st.Tag(idx))
+		}
+		t = st.Field(idx).Type()
+	}
+	return "", false
+}
+
+// isOptionalTag reports whether any key of a struct tag has the omitempty or
+// omitzero option.
+func isOptionalTag(tag string) bool {
+	for tag != "" {
+		// Skip to the next key:"value" pair, as reflect.StructTag.Lookup does.
+		i := strings.IndexByte(tag, ':')
+		if i < 0 || i+1 >= len(tag) || tag[i+1] != '"' {
+			return false
+		}
+		tag = tag[i+1:]
+		value, err := strconv.QuotedPrefix(tag)
+		if err != nil {
+			return false
+		}
+		tag = strings.TrimLeft(tag[len(value):], " ")
+
+		v, _ := strconv.Unquote(value)
+		for _, opt := range strings.Split(v, ",")[1:] {
+			if opt == "omitempty" || opt == "omitzero" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addressed reports whether the dereference at the top of stack is used as
+// an addressable operand: assigned to, incremented, having its address
+// taken, or receiving a pointer method call, either itself or through field
+// selectors, array indexes, and parentheses, as in *p = v or
+// (*u.Addr).City = v.
+func addressed(info *types.Info, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1].(ast.Expr)
+		switch n := stack[i].(type) {
+		case *ast.ParenExpr:
+		case *ast.SelectorExpr:
+			s, ok := info.Selections[n]
+			if !ok || n.X != child {
+				return false
+			}
+			if s.Kind() == types.MethodVal {
+				_, ptrRecv := s.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer)
+				return ptrRecv
+			}
+		case *ast.IndexExpr:
+			if n.X != child || !isArray(info.TypeOf(child)) {
+				return false
+			}
+		case *ast.SliceExpr:
+			return n.X == child && isArray(info.TypeOf(child))
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if lhs == child {
+					return true
+				}
+			}
+			return false
+		case *ast.IncDecStmt:
+			return n.X == child
+		case *ast.UnaryExpr:
+			return n.Op == token.AND
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// isArray reports whether t is an array type.
+func isArray(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Array)
+	return ok
+}
+
+// guarded reports whether the dereference at the top of stack is protected
+// by a nil check of the pointer expression x.
+func guarded(info *types.Info, x ast.Expr, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if child == n.Body && trueImpliesNonNil(info, n.Cond, x) {
+				return true
+			}
+			if child == n.Else && falseImpliesNonNil(info, n.Cond, x) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child == n.Y && n.Op == token.LAND && trueImpliesNonNil(info, n.X, x) {
+				return true
+			}
+			if child == n.Y && n.Op == token.LOR && falseImpliesNonNil(info, n.X, x) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if checkedBefore(info, n.List, child, x) {
+				return true
+			}
+		case *ast.CaseClause:
+			if checkedBefore(info, n.Body, child, x) {
+				return true
+			}
+		case *ast.CommClause:
+			if checkedBefore(info, n.Body, child, x) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkedBefore reports whether a statement preceding child in list ensures
+// that x is not nil: an if statement testing x == nil whose body returns,
+// branches, panics, exits, or assigns x.
+func checkedBefore(info *types.Info, list []ast.Stmt, child ast.Node, x ast.Expr) bool {
+	for _, stmt := range list {
+		if stmt == child {
+			return false
+		}
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Else != nil || !falseImpliesNonNil(info, ifStmt.Cond, x) {
+			continue
+		}
+		if terminates(info, ifStmt.Body) || assigns(info, ifStmt.Body, x) {
+			return true
+		}
+	}
+	return false
+}
+
+// trueImpliesNonNil reports whether cond being true implies x != nil.
+func trueImpliesNonNil(info *types.Info, cond, x ast.Expr) bool {
+	b, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch b.Op {
+	case token.NEQ:
+		return isNilCheck(info, b, x)
+	case token.LAND:
+		return trueImpliesNonNil(info, b.X, x) || trueImpliesNonNil(info, b.Y, x)
+	}
+	return false
+}
+
+// falseImpliesNonNil reports whether cond being false implies x != nil.
+func falseImpliesNonNil(info *types.Info, cond, x ast.Expr) bool {
+	b, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch b.Op {
+	case token.EQL:
+		return isNilCheck(info, b, x)
+	case token.LOR:
+		return falseImpliesNonNil(info, b.X, x) || falseImpliesNonNil(info, b.Y, x)
+	}
+	return false
+}
+
+// isNilCheck reports whether b compares x with nil.
+func isNilCheck(info *types.Info, b *ast.BinaryExpr, x ast.Expr) bool {
+	return (sameRef(info, b.X, x) && isNil(info, b.Y)) || (isNil(info, b.X) && sameRef(info, b.Y, x))
+}
+
+// isNil reports whether e is the predeclared nil.
+func isNil(info *types.Info, e ast.Expr) bool {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = info.Uses[id].(*types.Nil)
+	return ok
+}
+
+// sameRef reports whether a and b refer to the same variable or field
+// path, such as u.Addr.City, comparing the objects their identifiers
+// denote rather than their spelling. Index expressions match if their
+// operands match and their indexes are the same variable or constant.
+func sameRef(info *types.Info, a, b ast.Expr) bool {
+	switch a := ast.Unparen(a).(type) {
+	case *ast.Ident:
+		b, ok := ast.Unparen(b).(*ast.Ident)
+		return ok && info.ObjectOf(a) != nil && info.ObjectOf(a) == info.ObjectOf(b)
+	case *ast.SelectorExpr:
+		b, ok := ast.Unparen(b).(*ast.SelectorExpr)
+		if !ok || info.ObjectOf(a.Sel) == nil || info.ObjectOf(a.Sel) != info.ObjectOf(b.Sel) {
+			return false
+		}
+		return sameRef(info, a.X, b.X)
+	case *ast.StarExpr:
+		b, ok := ast.Unparen(b).(*ast.StarExpr)
+		return ok && sameRef(info, a.X, b.X)
+	case *ast.IndexExpr:
+		b, ok := ast.Unparen(b).(*ast.IndexExpr)
+		if !ok || !sameRef(info, a.X, b.X) {
+			return false
+		}
+		ca, cb := info.Types[a.Index].Value, info.Types[b.Index].Value
+		if ca != nil && cb != nil {
+			return constant.Compare(ca, token.EQL, cb)
+		}
+		return sameRef(info, a.Index, b.Index)
+	}
+	return false
+}
+
+// noReturn lists, by package path, the functions and methods that never
+// return to their caller.
+var noReturn = map[string]map[string]bool{
+	"log":     {"Fatal": true, "Fatalf": true, "Fatalln": true, "Panic": true, "Panicf": true, "Panicln": true},
+	"os":      {"Exit": true},
+	"runtime": {"Goexit": true},
+	"testing": {"Fatal": true, "Fatalf": true, "FailNow": true, "Skip": true, "Skipf": true, "SkipNow": true},
+}
+
+// terminates reports whether the last statement of block leaves it: a
+// return or branch statement, a call to panic, or a call to a function in
+// noReturn, such as os.Exit, log.Fatal, or t.Fatal.
+func terminates(info *types.Info, block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch s := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := ast.Unparen(s.X).(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		var id *ast.Ident
+		switch fun := ast.Unparen(call.Fun).(type) {
+		case *ast.Ident:
+			id = fun
+		case *ast.SelectorExpr:
+			id = fun.Sel
+		default:
+			return false
+		}
+		switch obj := info.Uses[id].(type) {
+		case *types.Builtin:
+			return obj.Name() == "panic"
+		case *types.Func:
+			return obj.Pkg() != nil && noReturn[obj.Pkg().Path()][obj.Name()]
+		}
+	}
+	return false
+}
+
+// assigns reports whether block assigns to x.
+func assigns(info *types.Info, block *ast.BlockStmt, x ast.Expr) bool {
+	found := false
+	ast.Inspect(block, func(n ast.Node) bool {
+		if as, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range as.Lhs {
+				if sameRef(info, lhs, x) {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// ptrImportName returns the name under which the file at the bottom of
+// stack imports package ptr.
+func ptrImportName(stack []ast.Node) (string, bool) {
+	f, ok := stack[0].(*ast.File)
+	if !ok {
+		return "", false
+	}
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == ptrPath {
+			if imp.Name == nil {
+				return "ptr", true
+			}
+			return imp.Name.Name, imp.Name.Name != "_" && imp.Name.Name != "."
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,12 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"go.companyinfo.dev/ptr/analyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), analyzer.Analyzer, "a")
+}
@@ -0,0 +1,13 @@
+// Command ptrvet runs the ptrderef analyzer as a go vet tool:
+//
+//	go vet -vettool=$(which ptrvet) ./...
+package main
+
+import (
+	"go.companyinfo.dev/ptr/analyzer"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(analyzer.Analyzer)
+}
@@ -0,0 +1,138 @@
+package a
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"go.companyinfo.dev/ptr"
+)
+
+type Base struct {
+	Owner *string `json:"owner,omitempty"`
+}
+
+type User struct {
+	Base
+	Name     *string `json:"name"`
+	Email    *string `json:"email,omitempty"`
+	Age      *int    `yaml:"age,omitzero" json:"age"`
+	Nickname string  `json:"nickname,omitempty"`
+}
+
+func reads(u User, pu *User) {
+	_ = *u.Name
+	_ = *u.Email // want `dereference of optional field Email may panic; use ptr.From or ptr.FromOr`
+	_ = *pu.Age  // want `dereference of optional field Age may panic`
+	_ = *u.Owner // want `dereference of optional field Owner may panic`
+	_ = u.Nickname
+	_ = ptr.From(u.Email)
+}
+
+func writes(u *User) {
+	*u.Email = "a@example.com" // want `dereference of optional field Email may panic; check it for nil first`
+	*u.Age++                   // want `dereference of optional field Age may panic; check it for nil first`
+	_ = &*u.Email              // want `dereference of optional field Email may panic; check it for nil first`
+	if u.Email != nil {
+		*u.Email = ""
+	}
+}
+
+func guards(u *User) int {
+	if u.Email != nil {
+		_ = *u.Email
+	}
+	if u.Email == nil {
+		_ = 0
+	} else {
+		_ = *u.Email
+	}
+	_ = u.Email != nil && *u.Email != ""
+	_ = u.Email == nil || *u.Email == ""
+	if u.Age != nil && *u.Age > 18 {
+		_ = *u.Email // want `dereference of optional field Email may panic`
+	}
+	if u.Age == nil {
+		return 0
+	}
+	return *u.Age
+}
+
+func exits(t *testing.T, tb testing.TB, l *log.Logger, u *User) {
+	if u.Email == nil {
+		t.Fatal("no email")
+	}
+	_ = *u.Email
+	if u.Age == nil {
+		tb.Skip()
+	}
+	_ = *u.Age
+	if u.Owner == nil {
+		os.Exit(1)
+	}
+	_ = *u.Owner
+	if u.Email == nil {
+		log.Fatalf("no email")
+	}
+	if u.Age == nil {
+		l.Fatal("no age")
+	}
+	_ = *u.Email + string(rune(*u.Age))
+}
+
+func assigned(u *User) string {
+	if u.Email == nil || *u.Email == "" {
+		u.Email = new(string)
+	}
+	return *u.Email
+}
+
+func closure(u *User) func() string {
+	if u.Email == nil {
+		return nil
+	}
+	return func() string {
+		return *u.Email // want `dereference of optional field Email may panic`
+	}
+}
+
+type Addr struct {
+	City string
+	Zip  [2]int
+}
+
+func (a *Addr) SetCity(c string) { a.City = c }
+
+func (a Addr) Label() string { return a.City }
+
+type Contact struct {
+	Addr  *Addr `json:"addr,omitempty"`
+	Count *int  `json:"count,omitempty"`
+}
+
+func addressedUses(c *Contact) {
+	(*c.Addr).City = "x"   // want `dereference of optional field Addr may panic`
+	(*c.Addr).Zip[0]++     // want `dereference of optional field Addr may panic`
+	(*c.Addr).SetCity("y") // want `dereference of optional field Addr may panic`
+	_ = (*c.Addr).Zip[:]   // want `dereference of optional field Addr may panic`
+	_ = (*c.Addr).Label()  // want `dereference of optional field Addr may panic`
+	_ = (*c.Addr).City     // want `dereference of optional field Addr may panic`
+	(*c.Count)++           // want `dereference of optional field Count may panic; check it for nil first`
+	(*c.Count) = 1         // want `dereference of optional field Count may panic; check it for nil first`
+}
+
+func sameObject(u, v *User, us []User, i int) {
+	if u.Email != nil {
+		_ = *v.Email // want `dereference of optional field Email may panic`
+	}
+	if u.Email != nil {
+		u := v
+		_ = *u.Email // want `dereference of optional field Email may panic`
+	}
+	if us[i].Email != nil {
+		_ = *us[i].Email
+	}
+	if us[0].Email != nil {
+		_ = *us[1].Email // want `dereference of optional field Email may panic`
+	}
+}
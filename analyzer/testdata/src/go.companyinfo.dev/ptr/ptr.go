@@ -0,0 +1,9 @@
+package ptr
+
+func From[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
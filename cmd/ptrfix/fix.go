@@ -0,0 +1,727 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ptrPath is the import path of package ptr.
+const ptrPath = "go.companyinfo.dev/ptr"
+
+// awsPaths lists the AWS SDK packages whose pointer helpers are rewritten.
+var awsPaths = map[string]bool{
+	"github.com/aws/aws-sdk-go/aws":    true,
+	"github.com/aws/aws-sdk-go-v2/aws": true,
+}
+
+// awsFuncs maps AWS SDK helper names to their package ptr equivalents. Both
+// SDK generations are covered: v1 dereferences with StringValue, v2 with
+// ToString. The v1 ValueMap helpers are not mapped because they drop nil
+// entries and never return a nil map, unlike any function of package ptr.
+var awsFuncs = func() map[string]string {
+	m := map[string]string{}
+	for _, b := range []string{
+		"String", "Bool", "Int", "Int8", "Int16", "Int32", "Int64",
+		"Uint", "Uint8", "Uint16", "Uint32", "Uint64", "Float32", "Float64",
+		"Time", "Duration",
+	} {
+		m[b] = b
+		m[b+"Value"] = "To" + b
+		m["To"+b] = "To" + b
+		m[b+"Slice"] = b + "Slice"
+		m[b+"ValueSlice"] = "To" + b + "Slice"
+		m["To"+b+"Slice"] = "To" + b + "Slice"
+		m[b+"Map"] = b + "Map"
+		m["To"+b+"Map"] = "To" + b + "Map"
+	}
+	return m
+}()
+
+// typedFuncs maps element types to the type-specific constructor of package
+// ptr, used when rewriting calls to local helpers.
+var typedFuncs = map[string]string{
+	"string": "String", "bool": "Bool", "byte": "Byte", "rune": "Rune",
+	"int": "Int", "int8": "Int8", "int16": "Int16", "int32": "Int32", "int64": "Int64",
+	"uint": "Uint", "uint8": "Uint8", "uint16": "Uint16", "uint32": "Uint32", "uint64": "Uint64",
+	"uintptr": "Uintptr", "float32": "Float32", "float64": "Float64",
+	"complex64": "Complex64", "complex128": "Complex128",
+	"time.Time": "Time", "time.Duration": "Duration",
+}
+
+// helper is a package-level function of the form
+//
+//	func name(v T) *T { return &v }
+type helper struct {
+	decl *ast.FuncDecl
+	elem ast.Expr
+}
+
+// fixPackage rewrites the files of one package in place and reports which
+// files changed. All files must belong to the same package so that calls to
+// local pointer helpers can be found across files.
+func fixPackage(fset *token.FileSet, files []*ast.File) map[*ast.File]bool {
+	helpers := map[string]*helper{}
+	for _, f := range files {
+		for name, h := range findHelpers(f) {
+			helpers[name] = h
+		}
+	}
+
+	changed := map[*ast.File]bool{}
+	for _, f := range files {
+		name, ok := ptrName(f)
+		if !ok {
+			continue
+		}
+		fx := &fileFixer{fset: fset, file: f, ptr: name, aws: awsNames(f), helpers: helpers}
+		if fx.fix() {
+			changed[f] = true
+		}
+	}
+
+	// Remove helpers that are no longer referenced anywhere in the package.
+	for name, h := range helpers {
+		if countRefs(files, name) > 0 {
+			continue
+		}
+		for _, f := range files {
+			if removeDecl(f, h.decl) {
+				changed[f] = true
+			}
+		}
+	}
+	return changed
+}
+
+// fileFixer applies the rewrites to a single file.
+type fileFixer struct {
+	fset    *token.FileSet
+	file    *ast.File
+	ptr     string          // local name of package ptr
+	aws     map[string]bool // local names of AWS SDK packages
+	helpers map[string]*helper
+	usedPtr bool
+	changed bool
+}
+
+func (fx *fileFixer) fix() bool {
+	ast.Inspect(fx.file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			n.List = fx.fixStmts(n.List)
+		case *ast.CaseClause:
+			n.Body = fx.fixStmts(n.Body)
+		case *ast.CommClause:
+			n.Body = fx.fixStmts(n.Body)
+		}
+		return true
+	})
+	rewriteExprs(fx.file, fx.fixCall)
+
+	for name := range fx.aws {
+		if usesPackage(fx.file, name) {
+			continue
+		}
+		if fx.usedPtr && replaceImport(fx.file, name, fx.ptr, ptrPath) {
+			continue
+		}
+		removeImport(fx.file, name)
+	}
+	if fx.usedPtr {
+		addImport(fx.file, fx.ptr, ptrPath)
+	}
+	if fx.changed {
+		ast.SortImports(fx.fset, fx.file)
+	}
+	return fx.changed
+}
+
+// fixStmts folds
+//
+//	tmp := v
+//	x = &tmp
+//
+// into x = ptr.To(v) when tmp is not used anywhere else. The address must be
+// taken in the statement right after the definition, where it is evaluated
+// exactly once and unconditionally, so that v still is too, and moving v
+// there must not change what it evaluates to (see movable). Definitions that
+// span several lines or carry a comment are left alone to keep the layout.
+func (fx *fileFixer) fixStmts(list []ast.Stmt) []ast.Stmt {
+	for i := 0; i+1 < len(list); i++ {
+		def, ok := list[i].(*ast.AssignStmt)
+		if !ok || def.Tok != token.DEFINE || len(def.Lhs) != 1 || len(def.Rhs) != 1 {
+			continue
+		}
+		if fx.spansLines(def) || fx.hasComment(def) {
+			continue
+		}
+		tmp, ok := def.Lhs[0].(*ast.Ident)
+		if !ok || tmp.Name == "_" || countIdents(list[i:], tmp.Name) != 2 {
+			continue
+		}
+		v := def.Rhs[0]
+		addr := findAddr(list[i+1], tmp.Name)
+		if addr == nil || !movable(list[i+1], addr, v) {
+			continue
+		}
+
+		rewriteExprs(list[i+1], func(e ast.Expr) ast.Expr {
+			if e != addr {
+				return e
+			}
+			movePos(v, addr.Pos())
+			if _, ok := v.(*ast.CompositeLit); ok {
+				return &ast.UnaryExpr{OpPos: addr.Pos(), Op: token.AND, X: v}
+			}
+			fx.usedPtr = true
+			call := fx.ptrCall("To", v)
+			call.Fun.(*ast.SelectorExpr).X.(*ast.Ident).NamePos = addr.Pos()
+			call.Lparen, call.Rparen = addr.Pos(), addr.Pos()
+			return call
+		})
+		removeComments(fx.file, def)
+		fx.joinLines(def, list[i+1])
+		list = append(list[:i], list[i+1:]...)
+		fx.changed = true
+	}
+	return list
+}
+
+// joinLines merges the line of a removed single-line statement, and the
+// blank lines between it and next, into the line before it, so that the
+// printer leaves no gap where the statement was.
+func (fx *fileFixer) joinLines(n, next ast.Node) {
+	tf := fx.fset.File(n.Pos())
+	line := tf.Line(n.Pos())
+	if line == 1 {
+		return
+	}
+	end := tf.Line(next.Pos())
+	for _, cg := range fx.file.Comments {
+		if cg.Pos() > n.End() && cg.Pos() < next.Pos() {
+			end = tf.Line(cg.Pos())
+			break
+		}
+	}
+	for i := line; i < end; i++ {
+		tf.MergeLine(line - 1)
+	}
+}
+
+// movePos moves every position in the subtree of n to pos, so that nodes
+// reused elsewhere are printed where they are inserted.
+func movePos(n ast.Node, pos token.Pos) {
+	posType := reflect.TypeOf(token.NoPos)
+	ast.Inspect(n, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Type() == posType && f.Interface() != token.NoPos {
+				f.Set(reflect.ValueOf(pos))
+			}
+		}
+		return true
+	})
+}
+
+// spansLines reports whether n spans more than one line.
+func (fx *fileFixer) spansLines(n ast.Node) bool {
+	return fx.fset.Position(n.Pos()).Line != fx.fset.Position(n.End()).Line
+}
+
+// hasComment reports whether a comment starts on the line of n.
+func (fx *fileFixer) hasComment(n ast.Node) bool {
+	line := fx.fset.Position(n.Pos()).Line
+	for _, cg := range fx.file.Comments {
+		if fx.fset.Position(cg.Pos()).Line == line {
+			return true
+		}
+	}
+	return false
+}
+
+// fixCall rewrites AWS SDK pointer helpers and local pointer helpers into
+// calls to package ptr.
+func (fx *fileFixer) fixCall(e ast.Expr) ast.Expr {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || call.Ellipsis.IsValid() {
+		return e
+	}
+
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		pkg, ok := fun.X.(*ast.Ident)
+		if !ok || !fx.aws[pkg.Name] {
+			return e
+		}
+		name, ok := awsFuncs[fun.Sel.Name]
+		if !ok {
+			return e
+		}
+		fx.usedPtr, fx.changed = true, true
+		return fx.ptrCall(name, call.Args[0])
+	case *ast.Ident:
+		h, ok := fx.helpers[fun.Name]
+		if !ok {
+			return e
+		}
+		if name, ok := typedFuncs[types.ExprString(h.elem)]; ok {
+			fx.usedPtr, fx.changed = true, true
+			return fx.ptrCall(name, call.Args[0])
+		}
+		// Without type information, ptr.To(Debug) or ptr.To(-1) would infer
+		// the argument's default type rather than the helper's element type,
+		// so the type argument is spelled out.
+		elem, ok := fx.typeExpr(h.elem, call.Pos())
+		if !ok {
+			return e
+		}
+		fx.usedPtr, fx.changed = true, true
+		to := fx.ptrCall("To", call.Args[0])
+		to.Fun = &ast.IndexExpr{X: to.Fun, Index: elem}
+		return to
+	}
+	return e
+}
+
+// typeExpr returns a copy of the type expression t placed at pos, or false
+// if t refers to a package that the file does not import under the same
+// name.
+func (fx *fileFixer) typeExpr(t ast.Expr, pos token.Pos) (ast.Expr, bool) {
+	expr, err := parser.ParseExpr(types.ExprString(t))
+	if err != nil {
+		return nil, false
+	}
+	ok := true
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, isSel := n.(*ast.SelectorExpr); isSel {
+			if id, isIdent := sel.X.(*ast.Ident); isIdent && !fx.imports(id.Name) {
+				ok = false
+			}
+		}
+		return ok
+	})
+	movePos(expr, pos)
+	return expr, ok
+}
+
+// imports reports whether the file imports a package under name.
+func (fx *fileFixer) imports(name string) bool {
+	for _, imp := range fx.file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err == nil && importName(imp, path) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (fx *fileFixer) ptrCall(name string, arg ast.Expr) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent(fx.ptr), Sel: ast.NewIdent(name)},
+		Args: []ast.Expr{arg},
+	}
+}
+
+// findHelpers returns the unexported functions of f that only return the
+// address of their single parameter. Exported helpers may be used by other
+// packages and are left alone.
+func findHelpers(f *ast.File) map[string]*helper {
+	helpers := map[string]*helper{}
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil || fd.Body == nil || ast.IsExported(fd.Name.Name) || fd.Type.TypeParams != nil {
+			continue
+		}
+		params, results := fd.Type.Params.List, fd.Type.Results
+		if len(params) != 1 || len(params[0].Names) != 1 || results == nil || len(results.List) != 1 || len(results.List[0].Names) != 0 {
+			continue
+		}
+		star, ok := results.List[0].Type.(*ast.StarExpr)
+		if !ok || types.ExprString(star.X) != types.ExprString(params[0].Type) {
+			continue
+		}
+		if len(fd.Body.List) != 1 {
+			continue
+		}
+		ret, ok := fd.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+		if addr, ok := ret.Results[0].(*ast.UnaryExpr); ok && addr.Op == token.AND {
+			if id, ok := addr.X.(*ast.Ident); ok && id.Name == params[0].Names[0].Name {
+				helpers[fd.Name.Name] = &helper{decl: fd, elem: star.X}
+			}
+		}
+	}
+	return helpers
+}
+
+// findAddr returns the single &name expression in stmt, or nil if there is
+// none or it is not evaluated exactly once whenever stmt runs.
+func findAddr(stmt ast.Stmt, name string) ast.Expr {
+	var found ast.Expr
+	var stack []ast.Node
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if u, ok := n.(*ast.UnaryExpr); ok && u.Op == token.AND {
+			if id, ok := u.X.(*ast.Ident); ok && id.Name == name && !conditional(stack) {
+				found = u
+			}
+		}
+		stack = append(stack, n)
+		return true
+	})
+	return found
+}
+
+// movable reports whether v can be evaluated at addr in stmt rather than
+// before stmt without changing its value. Constants always can. Otherwise
+// nothing evaluated before addr may write to what v reads, so stmt must not
+// have a call, receive, send, or assignment ahead of addr. A composite
+// literal, unlike the ptr.To call that replaces other values, is not itself
+// ordered with respect to the calls of stmt, so for one there must be no
+// call or receive after addr either.
+func movable(stmt ast.Stmt, addr, v ast.Expr) bool {
+	if isConstant(v) {
+		return true
+	}
+	_, lit := v.(*ast.CompositeLit)
+	ok := true
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if n == nil || !ok {
+			return false
+		}
+		before := n.End() <= addr.Pos()
+		after := n.Pos() >= addr.End()
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			ok = !before && !(lit && after)
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				ok = !before && !(lit && after)
+			}
+		case *ast.SendStmt, *ast.AssignStmt, *ast.IncDecStmt:
+			ok = !before
+		}
+		return ok
+	})
+	return ok
+}
+
+// isConstant reports whether e is built from literals alone, so that its
+// value does not depend on when it is evaluated.
+func isConstant(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.ParenExpr:
+		return isConstant(e.X)
+	case *ast.UnaryExpr:
+		return e.Op != token.ARROW && e.Op != token.AND && isConstant(e.X)
+	case *ast.BinaryExpr:
+		return isConstant(e.X) && isConstant(e.Y)
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				// A struct field name is an identifier; other keys must
+				// be constant too.
+				if _, field := kv.Key.(*ast.Ident); !field && !isConstant(kv.Key) {
+					return false
+				}
+				elt = kv.Value
+			}
+			if !isConstant(elt) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// conditional reports whether any of the enclosing nodes may evaluate its
+// children zero or several times: a loop, a function literal, a branch, or
+// a short-circuit operator.
+func conditional(stack []ast.Node) bool {
+	for _, n := range stack {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit,
+			*ast.IfStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt,
+			*ast.CaseClause, *ast.CommClause:
+			return true
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ptrName returns the name under which f refers to package ptr, or "ptr"
+// if it does not import it yet. It reports false if "ptr" is already taken
+// by another import or identifier, in which case the file is skipped.
+func ptrName(f *ast.File) (string, bool) {
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == ptrPath {
+			if imp.Name != nil {
+				return imp.Name.Name, imp.Name.Name != "_" && imp.Name.Name != "."
+			}
+			return "ptr", true
+		}
+	}
+	taken := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "ptr" {
+			taken = true
+		}
+		return !taken
+	})
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if importName(imp, path) == "ptr" {
+			taken = true
+		}
+	}
+	return "ptr", !taken
+}
+
+// awsNames returns the local names of the AWS SDK packages imported by f.
+func awsNames(f *ast.File) map[string]bool {
+	names := map[string]bool{}
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); awsPaths[path] {
+			names[importName(imp, path)] = true
+		}
+	}
+	return names
+}
+
+// importName returns the local name of an import, assuming that the package
+// name matches the last element of its path.
+func importName(imp *ast.ImportSpec, path string) string {
+	if imp.Name != nil {
+		return imp.Name.Name
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// usesPackage reports whether f contains a selector expression on name.
+func usesPackage(f *ast.File, name string) bool {
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == name {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
+
+// addImport adds an import of path under name to f unless it is present.
+// The new spec is placed at the end of the first import declaration so that
+// SortImports moves it into place.
+func addImport(f *ast.File, name, path string) {
+	for _, imp := range f.Imports {
+		if p, _ := strconv.Unquote(imp.Path.Value); p == path {
+			return
+		}
+	}
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+	if name != "ptr" {
+		spec.Name = ast.NewIdent(name)
+	}
+	f.Imports = append(f.Imports, spec)
+
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			last := gd.Specs[len(gd.Specs)-1]
+			spec.Path.ValuePos = last.End()
+			if !gd.Lparen.IsValid() {
+				gd.Lparen, gd.Rparen = gd.Specs[0].Pos(), last.End()
+			}
+			gd.Specs = append(gd.Specs, spec)
+			return
+		}
+	}
+	gd := &ast.GenDecl{Tok: token.IMPORT, TokPos: f.Name.End(), Specs: []ast.Spec{spec}}
+	f.Decls = append([]ast.Decl{gd}, f.Decls...)
+}
+
+// replaceImport turns the import with local name old into an import of path
+// under name, keeping its position. It reports false if there is no such
+// import or path is already imported.
+func replaceImport(f *ast.File, old, name, path string) bool {
+	for _, imp := range f.Imports {
+		if p, _ := strconv.Unquote(imp.Path.Value); p == path {
+			return false
+		}
+	}
+	for _, imp := range f.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		if importName(imp, p) != old {
+			continue
+		}
+		imp.Path.Value = strconv.Quote(path)
+		imp.Name = nil
+		if name != "ptr" {
+			imp.Name = ast.NewIdent(name)
+		}
+		return true
+	}
+	return false
+}
+
+// removeImport removes the import with the given local name from f.
+func removeImport(f *ast.File, name string) {
+	for i := 0; i < len(f.Decls); i++ {
+		gd, ok := f.Decls[i].(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		for j, spec := range gd.Specs {
+			imp := spec.(*ast.ImportSpec)
+			path, _ := strconv.Unquote(imp.Path.Value)
+			if importName(imp, path) != name {
+				continue
+			}
+			removeComments(f, imp)
+			gd.Specs = append(gd.Specs[:j], gd.Specs[j+1:]...)
+			for k, fi := range f.Imports {
+				if fi == imp {
+					f.Imports = append(f.Imports[:k], f.Imports[k+1:]...)
+					break
+				}
+			}
+			if len(gd.Specs) == 0 {
+				f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+// removeDecl removes decl from f, reporting whether f contained it.
+func removeDecl(f *ast.File, decl ast.Decl) bool {
+	for i, d := range f.Decls {
+		if d == decl {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Doc != nil {
+				removeComments(f, fd.Doc)
+			}
+			removeComments(f, decl)
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// removeComments drops the comments that lie within n, so that they are not
+// printed in place of a removed node.
+func removeComments(f *ast.File, n ast.Node) {
+	kept := f.Comments[:0]
+	for _, cg := range f.Comments {
+		if cg.Pos() < n.Pos() || cg.End() > n.End() {
+			kept = append(kept, cg)
+		}
+	}
+	f.Comments = kept
+}
+
+// countRefs counts the identifiers named name across files, excluding the
+// names of function declarations.
+func countRefs(files []*ast.File, name string) int {
+	n := 0
+	for _, f := range files {
+		ast.Inspect(f, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.FuncDecl:
+				if node.Recv == nil && node.Name.Name == name {
+					// Skip the declared name but count recursive references.
+					if node.Body != nil {
+						n += countIdents(node.Body.List, name)
+					}
+					return false
+				}
+			case *ast.SelectorExpr:
+				ast.Inspect(node.X, func(x ast.Node) bool {
+					if id, ok := x.(*ast.Ident); ok && id.Name == name {
+						n++
+					}
+					return true
+				})
+				return false
+			case *ast.Ident:
+				if node.Name == name {
+					n++
+				}
+			}
+			return true
+		})
+	}
+	return n
+}
+
+// countIdents counts the identifiers named name in stmts.
+func countIdents(stmts []ast.Stmt, name string) int {
+	n := 0
+	for _, s := range stmts {
+		ast.Inspect(s, func(node ast.Node) bool {
+			if id, ok := node.(*ast.Ident); ok && id.Name == name {
+				n++
+			}
+			return true
+		})
+	}
+	return n
+}
+
+var exprType = reflect.TypeOf((*ast.Expr)(nil)).Elem()
+
+// rewriteExprs walks the syntax tree rooted at n and replaces every
+// expression e with fn(e), children first.
+func rewriteExprs(n ast.Node, fn func(ast.Expr) ast.Expr) {
+	rewriteValue(reflect.ValueOf(n), fn)
+}
+
+func rewriteValue(v reflect.Value, fn func(ast.Expr) ast.Expr) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		if _, ok := v.Interface().(ast.Node); !ok {
+			return // *ast.Object, *ast.Scope
+		}
+		rewriteValue(v.Elem(), fn)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		rewriteValue(v.Elem(), fn)
+		if v.Type() == exprType && v.CanSet() {
+			v.Set(reflect.ValueOf(fn(v.Interface().(ast.Expr))))
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			rewriteValue(v.Index(i), fn)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			rewriteValue(v.Field(i), fn)
+		}
+	}
+}
@@ -0,0 +1,438 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fixSources runs fixPackage on the given files of one package and returns
+// the formatted results.
+func fixSources(t *testing.T, srcs ...string) []string {
+	t.Helper()
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for i, src := range srcs {
+		f, err := parser.ParseFile(fset, string(rune('a'+i))+".go", src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatalf("parsing input: %v", err)
+		}
+		files = append(files, f)
+	}
+	fixPackage(fset, files)
+
+	var out []string
+	for _, f := range files {
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, f); err != nil {
+			t.Fatalf("printing output: %v", err)
+		}
+		out = append(out, buf.String())
+	}
+	return out
+}
+
+func TestFixTemporaries(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "folds temporary",
+			in: `package p
+
+func f(n int) *int {
+	tmp := n * 2
+	return &tmp
+}
+`,
+			want: `package p
+
+import "go.companyinfo.dev/ptr"
+
+func f(n int) *int {
+	return ptr.To(n * 2)
+}
+`,
+		},
+		{
+			name: "composite literal",
+			in: `package p
+
+type T struct{ A int }
+
+func f() *T {
+	v := T{A: 1}
+	return &v
+}
+`,
+			want: `package p
+
+type T struct{ A int }
+
+func f() *T {
+	return &T{A: 1}
+}
+`,
+		},
+		{
+			name: "temporary used elsewhere",
+			in: `package p
+
+func f() *int {
+	v := 1
+	p := &v
+	v++
+	return p
+}
+`,
+		},
+		{
+			name: "address taken in closure",
+			in: `package p
+
+func f() func() *int {
+	v := 1
+	return func() *int { return &v }
+}
+`,
+		},
+		{
+			name: "address taken in loop",
+			in: `package p
+
+func f() (ps []*int) {
+	v := 1
+	for i := 0; i < 3; i++ {
+		ps = append(ps, &v)
+	}
+	return ps
+}
+`,
+		},
+		{
+			name: "value written before address is taken",
+			in: `package p
+
+func f(x int, inc func(*int) int, use func(int, *int) int) int {
+	tmp := x
+	return use(inc(&x), &tmp)
+}
+`,
+		},
+		{
+			name: "composite literal with call after address",
+			in: `package p
+
+type T struct{ A int }
+
+func f(x int, inc func(*int) int, use func(*T, int) int) int {
+	tmp := T{A: x}
+	return use(&tmp, inc(&x))
+}
+`,
+		},
+		{
+			name: "constant moved past call",
+			in: `package p
+
+func f(x int, inc func(*int) int, use func(int, *int) int) int {
+	tmp := 3
+	return use(inc(&x), &tmp)
+}
+`,
+			want: `package p
+
+import "go.companyinfo.dev/ptr"
+
+func f(x int, inc func(*int) int, use func(int, *int) int) int {
+	return use(inc(&x), ptr.To(3))
+}
+`,
+		},
+		{
+			name: "address taken in branch",
+			in: `package p
+
+func f(ok bool, load func() int) (p *int) {
+	v := load()
+	if ok {
+		p = &v
+	}
+	return p
+}
+`,
+		},
+		{
+			name: "address taken after short-circuit",
+			in: `package p
+
+func f(ok bool, load func() int, use func(*int) bool) bool {
+	v := load()
+	return ok && use(&v)
+}
+`,
+		},
+		{
+			name: "address taken in switch",
+			in: `package p
+
+func f(n int, load func() int) *int {
+	v := load()
+	switch n {
+	case 1:
+		return &v
+	}
+	return nil
+}
+`,
+		},
+		{
+			name: "definition with comment",
+			in: `package p
+
+func f(n int) *int {
+	v := n * 2 // doubled
+	return &v
+}
+`,
+		},
+		{
+			name: "no blank line left behind",
+			in: `package p
+
+func f(n int) *int {
+	v := n * 2
+
+	return &v
+}
+
+func g(n int) *int {
+	println(n)
+
+	v := n * 2
+	return &v
+}
+`,
+			want: `package p
+
+import "go.companyinfo.dev/ptr"
+
+func f(n int) *int {
+	return ptr.To(n * 2)
+}
+
+func g(n int) *int {
+	println(n)
+
+	return ptr.To(n * 2)
+}
+`,
+		},
+		{
+			name: "multi-line use keeps layout",
+			in: `package p
+
+func f(n int, use func(int, *int)) {
+	v := n * 2
+	use(
+		n,
+		&v,
+	)
+}
+`,
+			want: `package p
+
+import "go.companyinfo.dev/ptr"
+
+func f(n int, use func(int, *int)) {
+	use(
+		n,
+		ptr.To(n*2),
+	)
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = tt.in
+			}
+			if got := fixSources(t, tt.in)[0]; got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestFixAWS(t *testing.T) {
+	in := `package p
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func f(name string) {
+	p := aws.String(name)
+	fmt.Println(aws.StringValue(p), aws.Int64ValueSlice(nil))
+}
+`
+	want := `package p
+
+import (
+	"fmt"
+
+	"go.companyinfo.dev/ptr"
+)
+
+func f(name string) {
+	p := ptr.String(name)
+	fmt.Println(ptr.ToString(p), ptr.ToInt64Slice(nil))
+}
+`
+	if got := fixSources(t, in)[0]; got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	t.Run("keeps aws import still in use", func(t *testing.T) {
+		in := `package p
+
+import aws "github.com/aws/aws-sdk-go-v2/aws"
+
+var cfg aws.Config
+
+var name = aws.ToString(nil)
+`
+		got := fixSources(t, in)[0]
+		if !strings.Contains(got, `"github.com/aws/aws-sdk-go-v2/aws"`) || !strings.Contains(got, `"go.companyinfo.dev/ptr"`) {
+			t.Errorf("expected both imports, got:\n%s", got)
+		}
+		if !strings.Contains(got, "ptr.ToString(nil)") {
+			t.Errorf("expected rewritten call, got:\n%s", got)
+		}
+	})
+
+	t.Run("keeps nil-skipping map helpers", func(t *testing.T) {
+		in := `package p
+
+import "github.com/aws/aws-sdk-go/aws"
+
+var m = aws.StringValueMap(nil)
+`
+		if got := fixSources(t, in)[0]; !strings.Contains(got, "aws.StringValueMap(nil)") {
+			t.Errorf("expected StringValueMap to be kept, got:\n%s", got)
+		}
+	})
+}
+
+func TestFixHelpers(t *testing.T) {
+	helpers := `package p
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Order struct{ ID int }
+
+// strPtr returns a pointer to s.
+func strPtr(s string) *string { return &s }
+
+func durPtr(d time.Duration) *time.Duration { return &d }
+
+func orderPtr(o Order) *Order {
+	return &o
+}
+
+type level int
+
+const debug level = 1
+
+func levelPtr(l level) *level { return &l }
+
+func statePtr(s sql.NullString) *sql.NullString { return &s }
+`
+	callers := `package p
+
+func f(o Order) {
+	_ = strPtr("a")
+	_ = durPtr(time.Second)
+	_ = orderPtr(o)
+	_ = levelPtr(2)
+	_ = levelPtr(-1)
+	_ = levelPtr(debug)
+	_ = statePtr(sql.NullString{})
+}
+`
+	out := fixSources(t, helpers, callers)
+
+	for _, removed := range []string{"strPtr", "durPtr", "orderPtr", "levelPtr", "returns a pointer to s"} {
+		if strings.Contains(out[0], removed) {
+			t.Errorf("expected %s to be removed, got:\n%s", removed, out[0])
+		}
+	}
+	if !strings.Contains(out[0], "func statePtr") {
+		t.Errorf("expected statePtr to be kept for the caller without the sql import, got:\n%s", out[0])
+	}
+	for _, call := range []string{`ptr.String("a")`, `ptr.Duration(time.Second)`, `ptr.To[Order](o)`, `ptr.To[level](2)`, `ptr.To[level](-1)`, `ptr.To[level](debug)`, `statePtr(sql.NullString{})`} {
+		if !strings.Contains(out[1], call) {
+			t.Errorf("expected %s in output, got:\n%s", call, out[1])
+		}
+	}
+}
+
+func TestFixSkipsConflictingPtrName(t *testing.T) {
+	in := `package p
+
+import "k8s.io/utils/ptr"
+
+import "github.com/aws/aws-sdk-go/aws"
+
+var a = aws.String("a")
+var b = ptr.To("b")
+`
+	if got := fixSources(t, in)[0]; !strings.Contains(got, `aws.String("a")`) {
+		t.Errorf("expected file to be left alone, got:\n%s", got)
+	}
+}
+
+func TestAWSFuncsExist(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	funcs := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil {
+				funcs[fd.Name.Name] = true
+			}
+		}
+	}
+	for from, to := range awsFuncs {
+		if !funcs[to] {
+			t.Errorf("aws.%s maps to missing ptr.%s", from, to)
+		}
+	}
+	for typ, to := range typedFuncs {
+		if !funcs[to] {
+			t.Errorf("%s maps to missing ptr.%s", typ, to)
+		}
+	}
+}
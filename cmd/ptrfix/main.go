@@ -0,0 +1,182 @@
+// Command ptrfix rewrites hand-written pointer boilerplate into calls to
+// package ptr. It recognizes four patterns:
+//
+//	tmp := v; x = &tmp          =>  x = ptr.To(v)
+//	aws.String(v)               =>  ptr.String(v)
+//	aws.StringValue(p)          =>  ptr.ToString(p)
+//	func strPtr(v string) *string { return &v }
+//	strPtr(v)                   =>  ptr.String(v)
+//
+// The temporary variable is only folded when it is not used anywhere else
+// and when evaluating v where its address was taken, rather than before,
+// cannot change its value.
+// AWS SDK helpers of both SDK generations (aws-sdk-go and aws-sdk-go-v2)
+// with a ptr equivalent are rewritten, and the aws import is dropped once
+// it is no longer needed. Unexported local helpers that only return the
+// address of their parameter are replaced by the typed ptr function for
+// predeclared types and by ptr.To instantiated with the parameter type
+// otherwise, and their declarations are removed once nothing references
+// them.
+//
+// ptrfix works on syntax alone, without type information, so review the
+// resulting diff and build the code afterwards.
+//
+// Usage:
+//
+//	ptrfix [-l] [-w] [path ...]
+//
+// Paths may be files or directories; directories are processed
+// recursively, skipping vendor, testdata, and hidden directories, so "./..."
+// and "." are equivalent. Without
+// flags, the rewritten files are printed to standard output.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	list  = flag.Bool("l", false, "list files whose source would change")
+	write = flag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("ptrfix: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: ptrfix [-l] [-w] [path ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	dirs, err := collectFiles(paths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	failed := false
+	for _, dir := range sortedKeys(dirs) {
+		if err := processDir(dirs[dir]); err != nil {
+			log.Print(err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// collectFiles returns the Go files named by paths, grouped by directory.
+func collectFiles(paths []string) (map[string][]string, error) {
+	dirs := map[string][]string{}
+	add := func(file string) {
+		dir := filepath.Dir(file)
+		dirs[dir] = append(dirs[dir], file)
+	}
+	for _, path := range paths {
+		path = strings.TrimSuffix(path, "/...")
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			add(path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			name := d.Name()
+			if d.IsDir() {
+				if p != path && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(name, ".go") && !strings.HasPrefix(name, ".") && !strings.HasPrefix(name, "_") {
+				add(p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// processDir fixes the files of one directory, grouping them by package so
+// that external test packages are handled separately.
+func processDir(files []string) error {
+	fset := token.NewFileSet()
+	pkgs := map[string][]*ast.File{}
+	src := map[*ast.File][]byte{}
+	names := map[*ast.File]string{}
+	for _, name := range files {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(fset, name, data, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		pkgs[f.Name.Name] = append(pkgs[f.Name.Name], f)
+		src[f], names[f] = data, name
+	}
+
+	for _, pkg := range sortedKeys(pkgs) {
+		changed := fixPackage(fset, pkgs[pkg])
+		for _, f := range pkgs[pkg] {
+			if !changed[f] {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := format.Node(&buf, fset, f); err != nil {
+				return fmt.Errorf("%s: %w", names[f], err)
+			}
+			out := buf.Bytes()
+			if bytes.Equal(out, src[f]) {
+				continue
+			}
+			if *list {
+				fmt.Println(names[f])
+			}
+			if *write {
+				if err := os.WriteFile(names[f], out, 0o644); err != nil {
+					return err
+				}
+			}
+			if !*list && !*write {
+				os.Stdout.Write(out)
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
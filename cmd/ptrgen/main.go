@@ -0,0 +1,258 @@
+// Command ptrgen generates typed pointer helpers for user-defined types,
+// mirroring the built-in helpers of package ptr such as String, ToString,
+// StringSlice, ToStringMap, and MustString.
+//
+// It is meant to be run by go generate. Given a type Foo, it emits:
+//
+//	func FooPtr(v Foo) *Foo
+//	func ToFoo(p *Foo) Foo
+//	func MustFoo(p *Foo) Foo
+//	func FooSlice(vs []Foo) []*Foo
+//	func ToFooSlice(vs []*Foo) []Foo
+//	func FooMap(vs map[string]Foo) map[string]*Foo
+//	func ToFooMap(vs map[string]*Foo) map[string]Foo
+//
+// The constructor is named FooPtr rather than Foo because a function cannot
+// share its name with a type in the same package. Helpers for unexported
+// types are unexported as well (fooPtr, toFoo, ...).
+//
+// Usage:
+//
+//	//go:generate go run go.companyinfo.dev/ptr/cmd/ptrgen -type=Foo,Bar
+//
+// Flags:
+//
+//	-type    comma-separated list of type names (required)
+//	-output  output file name (default "<type>_ptr.go" for the first type)
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("ptrgen: ")
+
+	typeNames := flag.String("type", "", "comma-separated list of type names; must be set")
+	output := flag.String("output", "", `output file name; default "<type>_ptr.go"`)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: ptrgen -type=T[,T...] [-output file] [directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	name := *output
+	if name == "" {
+		name = strings.ToLower(types[0]) + "_ptr.go"
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// pkgInfo describes the package the helpers are generated into.
+type pkgInfo struct {
+	name  string
+	types map[string]*ast.TypeSpec
+}
+
+// loadPackage parses the non-test Go files in dir that match the current
+// build context, skipping files excluded by build constraints such as
+// //go:build ignore and files written by ptrgen itself, and collects the
+// package name and its top-level type declarations.
+func loadPackage(dir string) (*pkgInfo, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &pkgInfo{types: map[string]*ast.TypeSpec{}}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		match, err := build.Default.MatchFile(dir, filepath.Base(file))
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if generatedByPtrgen(f) {
+			continue
+		}
+		if pkg.name != "" && pkg.name != f.Name.Name {
+			return nil, fmt.Errorf("multiple packages in %s: %s and %s", dir, pkg.name, f.Name.Name)
+		}
+		pkg.name = f.Name.Name
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				pkg.types[ts.Name.Name] = ts
+			}
+		}
+	}
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return pkg, nil
+}
+
+// generatedByPtrgen reports whether f carries the header written by
+// generate, so that previous ptrgen output is not mistaken for user code.
+// Files produced by other generators, such as protoc or sqlc, declare types
+// of their own and are loaded as usual.
+func generatedByPtrgen(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "// Code generated by ptrgen ") && strings.HasSuffix(c.Text, " DO NOT EDIT.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generate returns the formatted source of the helpers for the named types.
+func generate(pkg *pkgInfo, types []string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by ptrgen -type=%s; DO NOT EDIT.\n\n", strings.Join(types, ","))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.name)
+	fmt.Fprintf(&buf, "import \"go.companyinfo.dev/ptr\"\n")
+
+	for _, name := range types {
+		ts, ok := pkg.types[name]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("type %s not found in package %s", name, pkg.name)
+		case ts.TypeParams != nil:
+			return nil, fmt.Errorf("type %s is generic; use the generic functions of package ptr instead", name)
+		}
+		writeHelpers(&buf, name)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting output: %w", err)
+	}
+	return src, nil
+}
+
+// writeHelpers writes the helpers for type t, with doc comments in the style
+// of the built-in type-specific functions.
+func writeHelpers(buf *bytes.Buffer, t string) {
+	n := helperNames(t)
+	fmt.Fprintf(buf, `
+// %[2]s returns a pointer to the provided %[1]s value.
+func %[2]s(v %[1]s) *%[1]s {
+	return ptr.To(v)
+}
+
+// %[3]s dereferences a *%[1]s and returns its value.
+// Returns the zero value if the pointer is nil.
+func %[3]s(p *%[1]s) %[1]s {
+	return ptr.From(p)
+}
+
+// %[4]s dereferences a *%[1]s and returns its value.
+// Panics if the pointer is nil. Use this only when nil is a programming error.
+func %[4]s(p *%[1]s) %[1]s {
+	return ptr.MustFrom(p)
+}
+
+// %[5]s converts a slice of %[1]s values to a slice of %[1]s pointers.
+func %[5]s(vs []%[1]s) []*%[1]s {
+	return ptr.ToSlice(vs)
+}
+
+// %[6]s converts a slice of %[1]s pointers to a slice of %[1]s values.
+// Nil pointers are converted to zero values.
+func %[6]s(vs []*%[1]s) []%[1]s {
+	return ptr.FromSlice(vs)
+}
+
+// %[7]s converts a map of %[1]s values to a map of %[1]s pointers.
+func %[7]s(vs map[string]%[1]s) map[string]*%[1]s {
+	return ptr.ToMap(vs)
+}
+
+// %[8]s converts a map of %[1]s pointers to a map of %[1]s values.
+// Nil pointers are converted to zero values.
+func %[8]s(vs map[string]*%[1]s) map[string]%[1]s {
+	return ptr.FromMap(vs)
+}
+`, t, n.ptr, n.to, n.must, n.slice, n.toSlice, n.mapOf, n.toMap)
+}
+
+// names holds the generated function names for one type.
+type names struct {
+	ptr, to, must, slice, toSlice, mapOf, toMap string
+}
+
+// helperNames derives the function names for type t. Helpers for unexported
+// types are unexported.
+func helperNames(t string) names {
+	r, size := utf8.DecodeRuneInString(t)
+	title := string(unicode.ToUpper(r)) + t[size:]
+	n := names{
+		ptr:     t + "Ptr",
+		to:      "To" + title,
+		must:    "Must" + title,
+		slice:   t + "Slice",
+		toSlice: "To" + title + "Slice",
+		mapOf:   t + "Map",
+		toMap:   "To" + title + "Map",
+	}
+	if !unicode.IsUpper(r) {
+		n.to = "to" + title
+		n.must = "must" + title
+		n.toSlice = "to" + title + "Slice"
+		n.toMap = "to" + title + "Map"
+	}
+	return n
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// moduleImporter resolves go.companyinfo.dev/ptr from the source of this
+// module, and every other import from the standard library.
+type moduleImporter struct {
+	fset *token.FileSet
+	std  types.Importer
+	ptr  *types.Package
+}
+
+func (m *moduleImporter) Import(path string) (*types.Package, error) {
+	if path != "go.companyinfo.dev/ptr" {
+		return m.std.Import(path)
+	}
+	if m.ptr != nil {
+		return m.ptr, nil
+	}
+	files, err := parseDir(m.fset, filepath.Join("..", ".."))
+	if err != nil {
+		return nil, err
+	}
+	conf := types.Config{Importer: m.std}
+	m.ptr, err = conf.Check(path, m.fset, files, nil)
+	return m.ptr, err
+}
+
+// parseDir parses the non-test Go files in dir that match the current build
+// context.
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		if match, err := build.Default.MatchFile(dir, filepath.Base(path)); err != nil || !match {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// typeCheck type-checks the package in dir together with the generated
+// source.
+func typeCheck(t *testing.T, dir string, src []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	files, err := parseDir(fset, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gen, err := parser.ParseFile(fset, "generated.go", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, src)
+	}
+	conf := types.Config{Importer: &moduleImporter{fset: fset, std: importer.Default()}}
+	if _, err := conf.Check("model", fset, append(files, gen), nil); err != nil {
+		t.Errorf("generated code does not type-check: %v\n%s", err, src)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	otherOS := "windows"
+	if runtime.GOOS == "windows" {
+		otherOS = "linux"
+	}
+	dir := writePackage(t, map[string]string{
+		"model.go":       "package model\n\ntype Order struct{ ID int }\n\ntype status string\n",
+		"model_test.go":  "package model_test\n",
+		"old_ptr.go":     "// Code generated by ptrgen -type=Old; DO NOT EDIT.\n\npackage model\n\ntype Old int\n",
+		"event.pb.go":    "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage model\n\ntype Event struct{ Name string }\n",
+		"gen.go":         "//go:build ignore\n\npackage main\n\ntype Order struct{}\n",
+		"model_other.go": "//go:build " + otherOS + "\n\npackage other\n",
+	})
+
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		t.Fatalf("loadPackage() error: %v", err)
+	}
+	if _, ok := pkg.types["Old"]; ok {
+		t.Error("types from previous ptrgen output should be skipped")
+	}
+	if _, ok := pkg.types["Event"]; !ok {
+		t.Error("types from files of other generators should be loaded")
+	}
+
+	src, err := generate(pkg, []string{"Order", "status", "Event"})
+	if err != nil {
+		t.Fatalf("generate() error: %v", err)
+	}
+	// Replace the previous output, as go generate would.
+	if err := os.Remove(filepath.Join(dir, "old_ptr.go")); err != nil {
+		t.Fatal(err)
+	}
+	typeCheck(t, dir, src)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, src)
+	}
+	if f.Name.Name != "model" {
+		t.Errorf("package = %s, want model", f.Name.Name)
+	}
+	if !strings.HasPrefix(string(src), "// Code generated by ptrgen -type=Order,status,Event; DO NOT EDIT.") {
+		t.Errorf("missing generated header:\n%s", src)
+	}
+
+	var funcs []string
+	for _, decl := range f.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			funcs = append(funcs, fd.Name.Name)
+		}
+	}
+	want := []string{
+		"OrderPtr", "ToOrder", "MustOrder", "OrderSlice", "ToOrderSlice", "OrderMap", "ToOrderMap",
+		"statusPtr", "toStatus", "mustStatus", "statusSlice", "toStatusSlice", "statusMap", "toStatusMap",
+		"EventPtr", "ToEvent", "MustEvent", "EventSlice", "ToEventSlice", "EventMap", "ToEventMap",
+	}
+	if !reflect.DeepEqual(funcs, want) {
+		t.Errorf("generated functions = %v, want %v", funcs, want)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"model.go": "package model\n\ntype Box[T any] struct{ V T }\n",
+	})
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		t.Fatalf("loadPackage() error: %v", err)
+	}
+	if _, err := generate(pkg, []string{"Missing"}); err == nil {
+		t.Error("expected error for unknown type")
+	}
+	if _, err := generate(pkg, []string{"Box"}); err == nil {
+		t.Error("expected error for generic type")
+	}
+
+	if _, err := loadPackage(t.TempDir()); err == nil {
+		t.Error("expected error for empty directory")
+	}
+	dir = writePackage(t, map[string]string{
+		"a.go": "package a\n",
+		"b.go": "package b\n",
+	})
+	if _, err := loadPackage(dir); err == nil {
+		t.Error("expected error for multiple packages")
+	}
+}
@@ -3,6 +3,8 @@ package ptr_test
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.companyinfo.dev/ptr"
@@ -630,3 +632,72 @@ func Example_tableValidation() {
 	// Age 18 is adult
 	// Age 0 is unknown
 }
+
+// Example_defaults demonstrates filling unset configuration fields
+func Example_defaults() {
+	type Config struct {
+		Host    *string
+		Port    *int
+		Verbose *bool
+	}
+
+	cfg := Config{Host: ptr.String("example.com")}
+	defaults := Config{
+		Host:    ptr.String("localhost"),
+		Port:    ptr.Int(8080),
+		Verbose: ptr.Bool(false),
+	}
+
+	if err := ptr.Defaults(&cfg, defaults); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fmt.Printf("Host: %s\n", *cfg.Host)
+	fmt.Printf("Port: %d\n", *cfg.Port)
+	fmt.Printf("Verbose: %v\n", *cfg.Verbose)
+
+	// Output:
+	// Host: example.com
+	// Port: 8080
+	// Verbose: false
+}
+
+// Example_wrap demonstrates composing multi-return functions with pointers
+func Example_wrap() {
+	port, err := ptr.Wrap(strconv.Atoi("8080"))
+	fmt.Println(*port, err)
+
+	port, err = ptr.Wrap(strconv.Atoi("invalid"))
+	fmt.Println(port == nil, err != nil)
+
+	settings := map[string]string{"host": "localhost"}
+	v, ok := settings["host"]
+	host := ptr.WrapOk(v, ok)
+	fmt.Println(ptr.FromOr(host, "none"))
+
+	// Output:
+	// 8080 <nil>
+	// true true
+	// localhost
+}
+
+// Example_chain demonstrates fluent pointer pipelines
+func Example_chain() {
+	normalize := func(input *string) string {
+		return ptr.Of(input).
+			Map(func(s string) string { return strings.TrimSpace(s) }).
+			Filter(func(s string) bool { return s != "" }).
+			Map(strings.ToLower).
+			OrElse("anonymous")
+	}
+
+	fmt.Println(normalize(ptr.String("  Alice ")))
+	fmt.Println(normalize(ptr.String("   ")))
+	fmt.Println(normalize(nil))
+
+	// Output:
+	// alice
+	// anonymous
+	// anonymous
+}
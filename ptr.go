@@ -24,7 +24,15 @@
 //	fmt.Println(ptr.From(user))
 package ptr
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNilPointer is returned by TryFrom when the pointer is nil.
+// Errors returned by Require also match it with errors.Is.
+var ErrNilPointer = errors.New("ptr: nil pointer")
 
 // To returns a pointer to the provided value.
 // This is useful for creating pointers to literals or values in a single expression.
@@ -37,6 +45,18 @@ func To[T any](v T) *T {
 	return &v
 }
 
+// Zero returns a pointer to a newly allocated zero value of type T.
+// It is equivalent to new(T) and performs the same, but reads more naturally
+// alongside To in table-driven tests and builders.
+//
+// Example:
+//
+//	count := ptr.Zero[int]()     // pointer to 0
+//	cfg := ptr.Zero[Config]()    // pointer to Config{}
+func Zero[T any]() *T {
+	return new(T)
+}
+
 // From dereferences the pointer and returns its value.
 // If the pointer is nil, it returns the zero value of type T.
 //
@@ -68,6 +88,38 @@ func FromOr[T any](p *T, defaultValue T) T {
 	return *p
 }
 
+// FromOrFunc dereferences the pointer and returns its value.
+// If the pointer is nil, it returns the result of calling def. Unlike FromOr,
+// the default is only computed when it is needed.
+//
+// Example:
+//
+//	deadline := ptr.FromOrFunc(req.Deadline, func() time.Time {
+//	    return time.Now().Add(30 * time.Second)
+//	})
+func FromOrFunc[T any](p *T, def func() T) T {
+	if p == nil {
+		return def()
+	}
+	return *p
+}
+
+// Value dereferences the pointer and reports whether it was non-nil.
+// If the pointer is nil, it returns the zero value of type T and false.
+//
+// Example:
+//
+//	if name, ok := ptr.Value(user.Nickname); ok {
+//	    fmt.Println("Hi,", name)
+//	}
+func Value[T any](p *T) (T, bool) {
+	if p == nil {
+		var zero T
+		return zero, false
+	}
+	return *p, true
+}
+
 // Equal returns true if both pointers point to equal values.
 // Returns true if both pointers are nil.
 // Returns false if only one pointer is nil.
@@ -88,6 +140,61 @@ func Equal[T comparable](a, b *T) bool {
 	return *a == *b
 }
 
+// EqualFunc returns true if both pointers point to values that are equal
+// according to the eq function. It has the same nil handling as Equal:
+// two nil pointers are equal, and a nil pointer never equals a non-nil one.
+// The eq function is only called when both pointers are non-nil.
+//
+// Example:
+//
+//	a := ptr.To("Hello")
+//	b := ptr.To("hello")
+//	ptr.EqualFunc(a, b, strings.EqualFold)  // true
+func EqualFunc[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return eq(*a, *b)
+}
+
+// EqualBy returns true if both pointers point to values that are equal
+// according to the type's own Equal method. This is the correct way to
+// compare types such as time.Time, where == also compares monotonic clock
+// readings and locations. Nil handling is the same as Equal.
+//
+// Example:
+//
+//	utc := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+//	local := utc.In(time.Local)
+//	ptr.EqualBy(&utc, &local)  // true
+func EqualBy[T interface{ Equal(T) bool }](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return (*a).Equal(*b)
+}
+
+// Contains returns true if the pointer is not nil and points to a value equal to v.
+// Returns false if the pointer is nil.
+//
+// Example:
+//
+//	ptr.Contains(req.Role, "admin")  // same as req.Role != nil && *req.Role == "admin"
+func Contains[T comparable](p *T, v T) bool {
+	return p != nil && *p == v
+}
+
+// ContainsFunc returns true if the pointer is not nil and its value satisfies pred.
+// Returns false if the pointer is nil, in which case pred is not called.
+// Unlike Filter, it does not allocate.
+//
+// Example:
+//
+//	ptr.ContainsFunc(req.Age, func(age int) bool { return age >= 18 })
+func ContainsFunc[T any](p *T, pred func(T) bool) bool {
+	return p != nil && pred(*p)
+}
+
 // Copy creates a new pointer with a shallow copy of the value.
 // For types containing pointers, slices, or maps, only the top-level
 // value is copied; nested pointers still reference the same memory.
@@ -106,6 +213,51 @@ func Copy[T any](p *T) *T {
 	return &v
 }
 
+// Cloner is implemented by types that know how to copy themselves.
+// Clone uses it in preference to a shallow copy.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// DeepCopier is implemented by types that provide a DeepCopy method
+// returning a value. Clone uses it in preference to a shallow copy. Clone
+// also recognizes the DeepCopy() *T methods produced by Kubernetes-style
+// code generators (deepcopy-gen, controller-gen), which do not implement
+// DeepCopier[T] because they return a pointer.
+type DeepCopier[T any] interface {
+	DeepCopy() T
+}
+
+// Clone creates a new pointer with a copy of the value, honoring the type's
+// own copy semantics. If T (or *T) implements Cloner[T], its Clone method is
+// used; otherwise, if it implements DeepCopier[T] or has a DeepCopy() *T
+// method, that DeepCopy method is used; otherwise Clone falls back to the
+// shallow copy made by Copy.
+// Returns nil if the input pointer is nil.
+//
+// Example:
+//
+//	type Doc struct{ Tags []string }
+//	func (d Doc) Clone() Doc { return Doc{Tags: append([]string(nil), d.Tags...)} }
+//
+//	c := ptr.Clone(ptr.To(Doc{Tags: []string{"a"}}))  // uses Doc.Clone
+func Clone[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	switch c := any(p).(type) {
+	case Cloner[T]:
+		v := c.Clone()
+		return &v
+	case DeepCopier[T]:
+		v := c.DeepCopy()
+		return &v
+	case interface{ DeepCopy() *T }:
+		return c.DeepCopy()
+	}
+	return Copy(p)
+}
+
 // IsNil returns true if the pointer is nil.
 //
 // Example:
@@ -132,6 +284,70 @@ func MustFrom[T any](p *T) T {
 	return *p
 }
 
+// Expect dereferences the pointer and returns its value.
+// Panics with msg and the pointer type if the pointer is nil, which makes the
+// offending field easier to identify than MustFrom's fixed message.
+//
+// Example:
+//
+//	email := ptr.Expect(user.Email, "user.Email must be set")
+//	// panics with "ptr: user.Email must be set (nil *string)" if user.Email is nil
+func Expect[T any](p *T, msg string) T {
+	if p == nil {
+		panic(fmt.Sprintf("ptr: %s (nil %T)", msg, p))
+	}
+	return *p
+}
+
+// TryFrom dereferences the pointer and returns its value.
+// If the pointer is nil, it returns the zero value of type T and ErrNilPointer,
+// so nil can be handled as an ordinary error instead of a panic.
+//
+// Example:
+//
+//	name, err := ptr.TryFrom(req.Name)
+//	if errors.Is(err, ptr.ErrNilPointer) {
+//	    return http.StatusBadRequest
+//	}
+func TryFrom[T any](p *T) (T, error) {
+	if p == nil {
+		var zero T
+		return zero, ErrNilPointer
+	}
+	return *p, nil
+}
+
+// Require dereferences the pointer and returns its value.
+// If the pointer is nil, it returns an error naming the missing field, such as
+// `field "email" is required`. The error matches ErrNilPointer with errors.Is.
+//
+// Example:
+//
+//	email, err := ptr.Require(req.Email, "email")
+//	if err != nil {
+//	    return err  // field "email" is required
+//	}
+func Require[T any](p *T, name string) (T, error) {
+	if p == nil {
+		var zero T
+		return zero, &requiredError{name: name}
+	}
+	return *p, nil
+}
+
+// requiredError is the error returned by Require for a nil pointer.
+type requiredError struct {
+	name string
+}
+
+func (e *requiredError) Error() string {
+	return fmt.Sprintf("field %q is required", e.name)
+}
+
+func (e *requiredError) Unwrap() error {
+	return ErrNilPointer
+}
+
 // Coalesce returns the first non-nil pointer from the provided list.
 // Returns nil if all pointers are nil.
 //
@@ -148,6 +364,74 @@ func Coalesce[T any](ptrs ...*T) *T {
 	return nil
 }
 
+// CoalesceValue returns the value of the first non-nil pointer from the list.
+// Returns def if all pointers are nil. It is equivalent to
+// FromOr(Coalesce(ptrs...), def).
+//
+// Example:
+//
+//	port := ptr.CoalesceValue(8080, envPort, configPort)  // 8080 if both are nil
+func CoalesceValue[T any](def T, ptrs ...*T) T {
+	for _, p := range ptrs {
+		if p != nil {
+			return *p
+		}
+	}
+	return def
+}
+
+// CoalesceFunc calls each function in order and returns the first non-nil
+// result. Functions after the first non-nil result are not called.
+// Returns nil if every function returns nil.
+//
+// Example:
+//
+//	cfg := ptr.CoalesceFunc(configFromEnv, configFromFile, configFromRemote)
+func CoalesceFunc[T any](fns ...func() *T) *T {
+	for _, fn := range fns {
+		if p := fn(); p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// Min returns the pointer to the smallest value among the non-nil arguments.
+// Nil pointers are ignored. If several pointers hold the smallest value,
+// the first of them is returned. Returns nil if all pointers are nil.
+//
+// Example:
+//
+//	m := ptr.Min(ptr.To(3), nil, ptr.To(1))  // returns pointer to 1
+//	m = ptr.Min[int](nil, nil)               // returns nil
+func Min[T Ordered](ptrs ...*T) *T {
+	var result *T
+	for _, p := range ptrs {
+		if p != nil && (result == nil || *p < *result) {
+			result = p
+		}
+	}
+	return result
+}
+
+// Max returns the pointer to the largest value among the non-nil arguments.
+// Nil pointers are ignored. If several pointers hold the largest value,
+// the first of them is returned. Returns nil if all pointers are nil.
+//
+// Example:
+//
+//	m := ptr.Max(ptr.To(3), nil, ptr.To(1))  // returns pointer to 3
+//	m = ptr.Max[int](nil, nil)               // returns nil
+func Max[T Ordered](ptrs ...*T) *T {
+	var result *T
+	for _, p := range ptrs {
+		if p != nil && (result == nil || *p > *result) {
+			result = p
+		}
+	}
+	return result
+}
+
 // Set sets the value of the pointer. If the pointer is nil, it's a no-op.
 // Returns true if the value was set, false if the pointer was nil.
 //
@@ -164,6 +448,38 @@ func Set[T any](p *T, value T) bool {
 	return true
 }
 
+// Exchange sets the value of the pointer like Set and returns the value it
+// held before. If the pointer is nil, it's a no-op and returns the zero
+// value of T and false.
+//
+// Example:
+//
+//	p := ptr.To(42)
+//	old, ok := ptr.Exchange(p, 100)  // old is 42, ok is true, *p is now 100
+//	old, ok = ptr.Exchange[int](nil, 100)  // old is 0, ok is false
+func Exchange[T any](p *T, v T) (old T, ok bool) {
+	if p == nil {
+		return old, false
+	}
+	old, *p = *p, v
+	return old, true
+}
+
+// MustSet sets the value of the pointer.
+// Panics if the pointer is nil. Use this instead of Set when a nil target is a
+// programming error that should not be silently ignored.
+//
+// Example:
+//
+//	ptr.MustSet(cfg.Timeout, 30*time.Second)
+//	ptr.MustSet[int](nil, 100)  // panics
+func MustSet[T any](p *T, v T) {
+	if p == nil {
+		panic("ptr: nil pointer passed to MustSet")
+	}
+	*p = v
+}
+
 // Map applies a transformation function to the pointer value.
 // Returns nil if the input pointer is nil.
 //
@@ -180,6 +496,54 @@ func Map[T, R any](p *T, fn func(T) R) *R {
 	return &result
 }
 
+// Map2 applies a transformation function to the values of two pointers.
+// Returns nil if either input pointer is nil, in which case fn is not called.
+//
+// Example:
+//
+//	width, height := ptr.To(3), ptr.To(4)
+//	area := ptr.Map2(width, height, func(w, h int) int { return w * h })  // pointer to 12
+func Map2[A, B, R any](a *A, b *B, fn func(A, B) R) *R {
+	if a == nil || b == nil {
+		return nil
+	}
+	result := fn(*a, *b)
+	return &result
+}
+
+// MapOr applies a transformation function to the pointer value and returns
+// the result. If the pointer is nil, it returns def and fn is not called.
+// Unlike combining Map and FromOr, no intermediate pointer is allocated.
+//
+// Example:
+//
+//	name := ptr.To("alice")
+//	n := ptr.MapOr(name, 0, func(s string) int { return len(s) })  // 5
+//	n = ptr.MapOr(nil, 0, func(s string) int { return len(s) })    // 0
+func MapOr[T, R any](p *T, def R, fn func(T) R) R {
+	if p == nil {
+		return def
+	}
+	return fn(*p)
+}
+
+// MapOrElse applies fn to the pointer value and returns the result.
+// If the pointer is nil, it returns the result of calling defFn instead.
+// Exactly one of the two functions is called.
+//
+// Example:
+//
+//	label := ptr.MapOrElse(user.Nickname,
+//	    func() string { return user.FirstName + " " + user.LastName },
+//	    strings.ToUpper,
+//	)
+func MapOrElse[T, R any](p *T, defFn func() R, fn func(T) R) R {
+	if p == nil {
+		return defFn()
+	}
+	return fn(*p)
+}
+
 // Or returns the first pointer if not nil, otherwise returns the second.
 // More ergonomic than Coalesce for the common two-pointer case.
 //
@@ -196,6 +560,57 @@ func Or[T any](a, b *T) *T {
 	return b
 }
 
+// OrElseGet returns p if it is not nil, otherwise returns the result of
+// calling supplier. Unlike Or, the fallback is computed lazily, so an
+// expensive lookup or allocation only happens when p is nil.
+//
+// Example:
+//
+//	cfg := ptr.OrElseGet(cached, func() *Config {
+//	    return loadConfigFromDisk()
+//	})
+func OrElseGet[T any](p *T, supplier func() *T) *T {
+	if p != nil {
+		return p
+	}
+	return supplier()
+}
+
+// And returns b if a is non-nil, otherwise returns nil.
+// It mirrors Or for conjunction and is handy in guard chains.
+//
+// Example:
+//
+//	token := ptr.To("secret")
+//	override := ptr.To(42)
+//	v := ptr.And(token, override)       // returns override
+//	v = ptr.And[string](nil, override)  // returns nil
+func And[A, B any](a *A, b *B) *B {
+	if a == nil {
+		return nil
+	}
+	return b
+}
+
+// Xor returns the non-nil pointer if exactly one of a and b is non-nil.
+// Returns nil if both are nil or both are non-nil, which makes it useful
+// for validating mutually exclusive fields.
+//
+// Example:
+//
+//	ptr.Xor(ptr.To(1), nil)        // returns pointer to 1
+//	ptr.Xor(ptr.To(1), ptr.To(2))  // returns nil
+//	ptr.Xor[int](nil, nil)         // returns nil
+func Xor[T any](a, b *T) *T {
+	if a != nil && b == nil {
+		return a
+	}
+	if a == nil && b != nil {
+		return b
+	}
+	return nil
+}
+
 // Filter returns the pointer if the predicate is true, otherwise returns nil.
 // If the pointer is nil, returns nil without calling the predicate.
 //
@@ -211,6 +626,29 @@ func Filter[T any](p *T, predicate func(T) bool) *T {
 	return p
 }
 
+// When returns p if cond is true, otherwise returns nil.
+// Unlike Filter, the condition does not depend on the pointer value.
+//
+// Example:
+//
+//	resp.Email = ptr.When(user.Email, viewer.IsAdmin)
+func When[T any](p *T, cond bool) *T {
+	if !cond {
+		return nil
+	}
+	return p
+}
+
+// Unless returns p if cond is false, otherwise returns nil.
+// It is the inverse of When.
+//
+// Example:
+//
+//	resp.Phone = ptr.Unless(user.Phone, user.HidePhone)
+func Unless[T any](p *T, cond bool) *T {
+	return When(p, !cond)
+}
+
 // FlatMap applies a transformation function that returns a pointer.
 // Returns nil if the input pointer is nil or if the function returns nil.
 // Useful for chaining operations that might return nil.
@@ -263,6 +701,38 @@ func Modify[T any](p *T, fn func(T) T) bool {
 	return true
 }
 
+// Inspect calls fn with the pointer value if the pointer is not nil and
+// returns p unchanged. It lets logging or metrics be inserted into a chain
+// of Filter/Map calls without breaking it.
+//
+// Example:
+//
+//	port := ptr.Inspect(cfg.Port, func(p int) {
+//	    log.Printf("using port %d", p)
+//	})
+func Inspect[T any](p *T, fn func(T)) *T {
+	if p != nil {
+		fn(*p)
+	}
+	return p
+}
+
+// Tap calls fn with the pointer itself, including when it is nil, and
+// returns p. Unlike Apply and Inspect, which skip nil pointers, Tap is
+// suited to debug assertions that need to observe the nil case.
+//
+// Example:
+//
+//	result := ptr.Tap(ptr.Map(input, parse), func(p *Config) {
+//	    if p == nil {
+//	        log.Println("config missing")
+//	    }
+//	})
+func Tap[T any](p *T, fn func(*T)) *T {
+	fn(p)
+	return p
+}
+
 // NonZero returns a pointer to the value if it's not the zero value,
 // otherwise returns nil. Useful for omitting zero values in JSON/APIs.
 //
@@ -279,6 +749,49 @@ func NonZero[T comparable](v T) *T {
 	return &v
 }
 
+// NonZeroOr returns a pointer to v if it's not the zero value,
+// otherwise returns a pointer to fallback. Unlike NonZero, the result is never nil.
+//
+// Example:
+//
+//	p := ptr.NonZeroOr(req.PageSize, 50)  // pointer to 50 if PageSize is 0
+func NonZeroOr[T comparable](v, fallback T) *T {
+	var zero T
+	if v == zero {
+		return &fallback
+	}
+	return &v
+}
+
+// If returns a pointer to v if cond is true, otherwise returns nil.
+// Useful for building sparse PATCH payloads declaratively.
+//
+// Example:
+//
+//	patch := UserPatch{
+//	    Name:  ptr.If(nameChanged, newName),
+//	    Email: ptr.If(emailChanged, newEmail),
+//	}
+func If[T any](cond bool, v T) *T {
+	if !cond {
+		return nil
+	}
+	return &v
+}
+
+// IfElse returns a pointer to a if cond is true, otherwise a pointer to b.
+// The result is never nil.
+//
+// Example:
+//
+//	mode := ptr.IfElse(debug, "verbose", "quiet")
+func IfElse[T any](cond bool, a, b T) *T {
+	if cond {
+		return &a
+	}
+	return &b
+}
+
 // IsZero returns true if the pointer is nil or points to a zero value.
 //
 // Example:
@@ -294,6 +807,34 @@ func IsZero[T comparable](p *T) bool {
 	return *p == zero
 }
 
+// OrZero returns p if it is not nil, otherwise returns a pointer to a newly
+// allocated zero value. The result is never nil.
+//
+// Example:
+//
+//	opts := ptr.OrZero(req.Options)  // safe to pass to APIs that reject nil
+func OrZero[T any](p *T) *T {
+	if p != nil {
+		return p
+	}
+	return new(T)
+}
+
+// NilIfZero returns nil if p is nil or points to the zero value, otherwise
+// returns p. It is the inverse of OrZero and is useful before marshaling
+// with omitempty.
+//
+// Example:
+//
+//	ptr.NilIfZero(ptr.To(0))   // nil
+//	ptr.NilIfZero(ptr.To(42))  // pointer to 42
+func NilIfZero[T comparable](p *T) *T {
+	if IsZero(p) {
+		return nil
+	}
+	return p
+}
+
 // Swap exchanges the values of two pointers.
 // Does nothing if either pointer is nil.
 //
@@ -309,6 +850,99 @@ func Swap[T any](a, b *T) {
 	*a, *b = *b, *a
 }
 
+// Take returns the pointer stored in *pp and sets *pp to nil, leaving the
+// caller with sole ownership of the value. Returns nil if pp or *pp is nil.
+//
+// Example:
+//
+//	token := ptr.Take(&session.PendingToken)  // session.PendingToken is now nil
+//	if token != nil {
+//	    consume(*token)
+//	}
+func Take[T any](pp **T) *T {
+	if pp == nil {
+		return nil
+	}
+	p := *pp
+	*pp = nil
+	return p
+}
+
+// Replace stores a pointer to v in *pp and returns the previous pointer,
+// which may be nil. Does nothing and returns nil if pp is nil.
+//
+// Example:
+//
+//	old := ptr.Replace(&cache.Current, fresh)
+//	if old != nil {
+//	    old.Close()
+//	}
+func Replace[T any](pp **T, v T) *T {
+	if pp == nil {
+		return nil
+	}
+	old := *pp
+	*pp = &v
+	return old
+}
+
+// SetIfNil stores a pointer to v in *pp only if *pp is currently nil.
+// Returns true if the value was stored, false if *pp was already set or pp is nil.
+//
+// Example:
+//
+//	ptr.SetIfNil(&cfg.Port, 8080)  // cfg.Port defaults to 8080 if unset
+func SetIfNil[T any](pp **T, v T) bool {
+	if pp == nil || *pp != nil {
+		return false
+	}
+	*pp = &v
+	return true
+}
+
+// GetOrInit returns *pp, first storing a pointer to init() in it if it is nil.
+// init is only called when *pp is nil. Returns nil if pp is nil.
+//
+// Example:
+//
+//	func (s *Server) cache() *Cache {
+//	    return ptr.GetOrInit(&s.lazyCache, newCache)
+//	}
+func GetOrInit[T any](pp **T, init func() T) *T {
+	if pp == nil {
+		return nil
+	}
+	if *pp == nil {
+		v := init()
+		*pp = &v
+	}
+	return *pp
+}
+
+// Flatten collapses a double pointer into a single pointer.
+// Returns nil if either level is nil.
+//
+// Example:
+//
+//	var nickname **string  // optional-of-optional from generated code
+//	n := ptr.Flatten(nickname)  // *string, nil if either level is nil
+func Flatten[T any](pp **T) *T {
+	if pp == nil {
+		return nil
+	}
+	return *pp
+}
+
+// From2 dereferences a double pointer and returns its value.
+// If either level is nil, it returns the zero value of type T.
+//
+// Example:
+//
+//	name := ptr.From2(resp.Nickname)  // "" if either level is nil
+func From2[T any](pp **T) T {
+	return From(Flatten(pp))
+}
+
 // Bind applies a function that transforms a pointer to another pointer.
 // This is an alias for FlatMap, provided for developers familiar with monadic bind operations.
 // Returns nil if the input pointer is nil or if the function returns nil.
@@ -326,6 +960,71 @@ func Bind[T, R any](p *T, fn func(T) *R) *R {
 	return FlatMap(p, fn)
 }
 
+// UnwrapOr dereferences the pointer, returning def if it is nil.
+// This is an alias for FromOr, provided for developers familiar with Rust's Option API.
+//
+// Example:
+//
+//	port := ptr.UnwrapOr(cfg.Port, 8080)
+func UnwrapOr[T any](p *T, def T) T {
+	return FromOr(p, def)
+}
+
+// UnwrapOrDefault dereferences the pointer, returning the zero value if it is nil.
+// This is an alias for From, provided for developers familiar with Rust's Option API.
+//
+// Example:
+//
+//	name := ptr.UnwrapOrDefault(user.Name)  // "" if user.Name is nil
+func UnwrapOrDefault[T any](p *T) T {
+	return From(p)
+}
+
+// UnwrapOrElse dereferences the pointer, returning the result of def if it is nil.
+// This is an alias for FromOrFunc, provided for developers familiar with Rust's Option API.
+//
+// Example:
+//
+//	at := ptr.UnwrapOrElse(req.Timestamp, time.Now)
+func UnwrapOrElse[T any](p *T, def func() T) T {
+	return FromOrFunc(p, def)
+}
+
+// Pair holds two values of possibly different types, as produced by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two pointers into a pointer to a Pair of their values.
+// Returns nil unless both pointers are non-nil, which makes
+// "both of these optional fields are present" checks declarative.
+//
+// Example:
+//
+//	p := ptr.Zip(ptr.To("alice"), ptr.To(30))  // pointer to Pair{"alice", 30}
+//	p = ptr.Zip(ptr.To("alice"), (*int)(nil))  // nil
+func Zip[A, B any](a *A, b *B) *Pair[A, B] {
+	if a == nil || b == nil {
+		return nil
+	}
+	return &Pair[A, B]{First: *a, Second: *b}
+}
+
+// Unzip splits a pointer to a Pair into pointers to copies of its values.
+// Returns two nil pointers if p is nil.
+//
+// Example:
+//
+//	name, age := ptr.Unzip(ptr.Zip(ptr.To("alice"), ptr.To(30)))
+func Unzip[A, B any](p *Pair[A, B]) (*A, *B) {
+	if p == nil {
+		return nil, nil
+	}
+	a, b := p.First, p.Second
+	return &a, &b
+}
+
 // GetOr returns the value if pointer is non-nil, otherwise returns the default value.
 // This is an alias for FromOr with a more intuitive name for configuration use cases.
 //
@@ -337,13 +1036,61 @@ func GetOr[T any](p *T, defaultValue T) T {
 	return FromOr(p, defaultValue)
 }
 
+// Wrap converts a (value, error) result into a pointer result.
+// Returns a pointer to v and a nil error if err is nil,
+// otherwise returns a nil pointer and err.
+//
+// Example:
+//
+//	port, err := ptr.Wrap(strconv.Atoi("8080"))  // pointer to 8080, nil
+//	port, err = ptr.Wrap(strconv.Atoi("abc"))    // nil, error
+func Wrap[T any](v T, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// WrapOk converts a (value, ok) result into a pointer.
+// Returns a pointer to v if ok is true, otherwise returns nil.
+//
+// Example:
+//
+//	home := ptr.WrapOk(os.LookupEnv("HOME"))  // pointer to the value, or nil if unset
+func WrapOk[T any](v T, ok bool) *T {
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// Unwrap converts a (pointer, error) result into a (value, error) result.
+// If err is not nil, it returns the zero value of T and err.
+// Otherwise it dereferences p, returning the zero value if p is nil.
+//
+// Example:
+//
+//	v, err := ptr.Unwrap(lookupUser(id))  // User value instead of *User
+func Unwrap[T any](p *T, err error) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return From(p), nil
+}
+
 // ToSlice converts a slice of values to a slice of pointers.
 // Returns nil if the input slice is nil.
 //
+// The returned pointers point into the input slice's backing array, so
+// writing through them modifies values, and vice versa. Use ToSliceCopy
+// when the result must not alias the input.
+//
 // Example:
 //
 //	values := []int{1, 2, 3}
 //	ptrs := ptr.ToSlice(values)  // []*int with pointers to 1, 2, 3
+//	*ptrs[0] = 10                // values[0] is now 10
 func ToSlice[T any](values []T) []*T {
 	if values == nil {
 		return nil
@@ -355,6 +1102,24 @@ func ToSlice[T any](values []T) []*T {
 	return result
 }
 
+// ToSliceCopy converts a slice of values to a slice of pointers to copies
+// of the values. Unlike ToSlice, the result does not alias the input.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	values := []int{1, 2, 3}
+//	ptrs := ptr.ToSliceCopy(values)
+//	*ptrs[0] = 10  // values[0] is still 1
+func ToSliceCopy[T any](values []T) []*T {
+	if values == nil {
+		return nil
+	}
+	copied := make([]T, len(values))
+	copy(copied, values)
+	return ToSlice(copied)
+}
+
 // FromSlice converts a slice of pointers to a slice of values.
 // Nil pointers are converted to zero values.
 // Returns nil if the input slice is nil.
@@ -374,6 +1139,27 @@ func FromSlice[T any](ptrs []*T) []T {
 	return result
 }
 
+// FromSliceNonNil converts a slice of pointers to a slice of values,
+// omitting nil pointers instead of converting them to zero values.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	ptrs := []*int{ptr.To(1), nil, ptr.To(0)}
+//	values := ptr.FromSliceNonNil(ptrs)  // []int{1, 0}
+func FromSliceNonNil[T any](ptrs []*T) []T {
+	if ptrs == nil {
+		return nil
+	}
+	result := make([]T, 0, len(ptrs))
+	for _, p := range ptrs {
+		if p != nil {
+			result = append(result, *p)
+		}
+	}
+	return result
+}
+
 // String returns a pointer to the provided string value.
 func String(v string) *string {
 	return To(v)
@@ -561,6 +1347,12 @@ func ToTime(p *time.Time) time.Time {
 	return From(p)
 }
 
+// EqualTime returns true if both time.Time pointers represent the same instant,
+// using time.Time.Equal rather than ==. Nil handling is the same as Equal.
+func EqualTime(a, b *time.Time) bool {
+	return EqualBy(a, b)
+}
+
 // Duration returns a pointer to the provided time.Duration value.
 func Duration(v time.Duration) *time.Duration {
 	return To(v)
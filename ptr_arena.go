@@ -0,0 +1,65 @@
+package ptr
+
+import "unsafe"
+
+// arenaChunkBytes is the approximate size in bytes of each chunk allocated
+// by an Arena. Chunks always hold at least one value.
+const arenaChunkBytes = 64 << 10
+
+// Arena allocates pointed-to values of type T from chunks of about 64 KiB,
+// so creating many short-lived pointers costs one allocation per chunk
+// instead of one per value. Go methods cannot have their own type
+// parameters, so an Arena holds a single type; create one per type with
+// NewArena.
+//
+// An Arena is not safe for concurrent use. Every pointer returned by To
+// keeps its whole chunk reachable, so a single long-lived pointer retains
+// up to 64 KiB of memory (or one value, for larger types). Values that
+// outlive the rest of their batch should be copied out, for example with
+// Clone.
+//
+// Example:
+//
+//	a := ptr.NewArena[int]()
+//	for _, row := range rows {
+//	    out = append(out, Record{Count: a.To(row.Count)})
+//	}
+//	a.Free()
+type Arena[T any] struct {
+	chunk []T
+}
+
+// NewArena returns an empty Arena for values of type T.
+func NewArena[T any]() *Arena[T] {
+	return &Arena[T]{}
+}
+
+// To returns a pointer to a copy of v allocated from the arena. The pointer
+// keeps the chunk it was allocated from alive until it is no longer used.
+func (a *Arena[T]) To(v T) *T {
+	if len(a.chunk) == cap(a.chunk) {
+		a.chunk = make([]T, 0, arenaChunkLen[T]())
+	}
+	a.chunk = append(a.chunk, v)
+	return &a.chunk[len(a.chunk)-1]
+}
+
+// Free drops the arena's reference to its current chunk, so that To
+// allocates from a fresh one. Earlier chunks are not referenced by the arena
+// at all; each is reclaimed by the garbage collector once none of the
+// pointers allocated from it are in use, whether or not Free is called.
+// Pointers handed out earlier stay valid.
+func (a *Arena[T]) Free() {
+	a.chunk = nil
+}
+
+// arenaChunkLen returns the number of values of type T that fit in one
+// arena chunk.
+func arenaChunkLen[T any]() int {
+	var zero T
+	size := unsafe.Sizeof(zero)
+	if size == 0 || size >= arenaChunkBytes {
+		return 1
+	}
+	return int(arenaChunkBytes / size)
+}
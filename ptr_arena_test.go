@@ -0,0 +1,45 @@
+package ptr
+
+import "testing"
+
+func TestArena(t *testing.T) {
+	a := NewArena[int]()
+	ptrs := make([]*int, 0, arenaChunkLen[int]()*2+1)
+	for i := 0; i < cap(ptrs); i++ {
+		ptrs = append(ptrs, a.To(i))
+	}
+	for i, p := range ptrs {
+		if *p != i {
+			t.Fatalf("*ptrs[%d] = %d, want %d", i, *p, i)
+		}
+	}
+
+	*ptrs[0] = -1
+	if *ptrs[1] != 1 {
+		t.Error("expected pointers to refer to distinct values")
+	}
+
+	a.Free()
+	p := a.To(42)
+	if *p != 42 || *ptrs[len(ptrs)-1] != len(ptrs)-1 {
+		t.Error("expected earlier pointers to stay valid after Free")
+	}
+}
+
+func TestArenaChunkLen(t *testing.T) {
+	if got := arenaChunkLen[int64](); got != arenaChunkBytes/8 {
+		t.Errorf("arenaChunkLen[int64]() = %d, want %d", got, arenaChunkBytes/8)
+	}
+	if got := arenaChunkLen[[1 << 20]byte](); got != 1 {
+		t.Errorf("arenaChunkLen[[1 << 20]byte]() = %d, want 1", got)
+	}
+	if got := arenaChunkLen[struct{}](); got != 1 {
+		t.Errorf("arenaChunkLen[struct{}]() = %d, want 1", got)
+	}
+
+	a := NewArena[[1 << 20]byte]()
+	p := a.To([1 << 20]byte{1})
+	if p[0] != 1 || cap(a.chunk) != 1 {
+		t.Errorf("large values: cap(chunk) = %d, want 1", cap(a.chunk))
+	}
+}
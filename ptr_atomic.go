@@ -0,0 +1,56 @@
+//go:build go1.19
+
+package ptr
+
+import "sync/atomic"
+
+// LoadOr atomically loads the value stored in ap.
+// If ap holds nil, it returns def.
+//
+// Example:
+//
+//	var current atomic.Pointer[Config]
+//	cfg := ptr.LoadOr(&current, defaultConfig)
+func LoadOr[T any](ap *atomic.Pointer[T], def T) T {
+	return FromOr(ap.Load(), def)
+}
+
+// StoreValue atomically stores a pointer to a copy of v in ap.
+//
+// Example:
+//
+//	ptr.StoreValue(&current, newConfig)
+func StoreValue[T any](ap *atomic.Pointer[T], v T) {
+	ap.Store(&v)
+}
+
+// SwapValue atomically stores a pointer to a copy of v in ap and returns the
+// previous pointer, which may be nil.
+//
+// Example:
+//
+//	old := ptr.SwapValue(&current, newConfig)
+func SwapValue[T any](ap *atomic.Pointer[T], v T) *T {
+	return ap.Swap(&v)
+}
+
+// CompareAndSwapValue atomically replaces the value in ap with newVal if the
+// current value equals old, comparing values rather than pointers.
+// Returns false if ap holds nil or a different value.
+//
+// Example:
+//
+//	var state atomic.Pointer[string]
+//	ptr.StoreValue(&state, "idle")
+//	ptr.CompareAndSwapValue(&state, "idle", "running")  // true
+func CompareAndSwapValue[T comparable](ap *atomic.Pointer[T], old, newVal T) bool {
+	for {
+		cur := ap.Load()
+		if cur == nil || *cur != old {
+			return false
+		}
+		if ap.CompareAndSwap(cur, &newVal) {
+			return true
+		}
+	}
+}
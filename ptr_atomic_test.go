@@ -0,0 +1,68 @@
+//go:build go1.19
+
+package ptr
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAtomicHelpers(t *testing.T) {
+	var ap atomic.Pointer[string]
+
+	if got := LoadOr(&ap, "default"); got != "default" {
+		t.Errorf("LoadOr() on empty pointer = %q, want default", got)
+	}
+
+	StoreValue(&ap, "idle")
+	if got := LoadOr(&ap, "default"); got != "idle" {
+		t.Errorf("LoadOr() = %q, want idle", got)
+	}
+
+	if CompareAndSwapValue(&ap, "running", "stopped") {
+		t.Error("expected CompareAndSwapValue to fail for a different value")
+	}
+	if !CompareAndSwapValue(&ap, "idle", "running") {
+		t.Error("expected CompareAndSwapValue to succeed for an equal value")
+	}
+
+	old := SwapValue(&ap, "stopped")
+	if old == nil || *old != "running" {
+		t.Errorf("SwapValue() = %v, want pointer to running", old)
+	}
+	if got := *ap.Load(); got != "stopped" {
+		t.Errorf("expected stopped, got %q", got)
+	}
+
+	var empty atomic.Pointer[string]
+	if CompareAndSwapValue(&empty, "", "x") {
+		t.Error("expected CompareAndSwapValue to fail for nil pointer")
+	}
+}
+
+func TestCompareAndSwapValueConcurrent(t *testing.T) {
+	var counter atomic.Pointer[int]
+	StoreValue(&counter, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					cur := LoadOr(&counter, 0)
+					if CompareAndSwapValue(&counter, cur, cur+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := LoadOr(&counter, -1); got != 800 {
+		t.Errorf("expected 800, got %d", got)
+	}
+}
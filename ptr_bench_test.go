@@ -31,6 +31,23 @@ func BenchmarkToStruct(b *testing.B) {
 	}
 }
 
+// intPtrSink keeps the results of the allocation benchmarks alive, so the
+// compiler cannot optimize the allocations away.
+var intPtrSink *int
+
+// Benchmark Zero against new
+func BenchmarkZero(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		intPtrSink = Zero[int]()
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		intPtrSink = new(int)
+	}
+}
+
 // Benchmark generic From function
 func BenchmarkFrom(b *testing.B) {
 	p := To(42)
@@ -507,3 +524,155 @@ func BenchmarkSwapNil(b *testing.B) {
 		Swap[int](nil, bVal)
 	}
 }
+
+func BenchmarkModifySlice(b *testing.B) {
+	ptrs := []*int{To(1), nil, To(3), To(4), nil}
+	transform := func(v int) int { return v + 1 }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ModifySlice(ptrs, transform)
+	}
+}
+
+func BenchmarkChain(b *testing.B) {
+	p := To(42)
+	double := func(v int) int { return v * 2 }
+	positive := func(v int) bool { return v > 0 }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Of(p).Map(double).Filter(positive).OrElse(0)
+	}
+}
+
+func BenchmarkDeepCopy(b *testing.B) {
+	type Data struct {
+		Name string
+		Tags []string
+		Meta map[string]*int
+	}
+	p := To(Data{Name: "Alice", Tags: []string{"a", "b"}, Meta: map[string]*int{"x": To(1)}})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = DeepCopy(p)
+	}
+}
+
+func BenchmarkClone(b *testing.B) {
+	p := To(42)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Clone(p)
+	}
+}
+
+func BenchmarkMin(b *testing.B) {
+	a, c := To(3), To(1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Min(a, nil, c)
+	}
+}
+
+func BenchmarkFromSliceNonNil(b *testing.B) {
+	ptrs := []*int{To(1), nil, To(3), nil, To(5)}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = FromSliceNonNil(ptrs)
+	}
+}
+
+func BenchmarkMapSlice(b *testing.B) {
+	ptrs := []*int{To(1), nil, To(3), To(4), nil}
+	double := func(v int) int { return v * 2 }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = MapSlice(ptrs, double)
+	}
+}
+
+// Benchmark IntCached against Int
+func BenchmarkIntCached(b *testing.B) {
+	var sink *int
+	for i := 0; i < b.N; i++ {
+		sink = IntCached(i & 1023)
+	}
+	_ = sink
+}
+
+func BenchmarkIntUncached(b *testing.B) {
+	var sink *int
+	for i := 0; i < b.N; i++ {
+		sink = Int(i & 1023)
+	}
+	_ = sink
+}
+
+// Benchmark AllocSlice against To in a loop
+func BenchmarkAllocSlice(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = AllocSlice[int](1000)
+	}
+}
+
+func BenchmarkAllocSliceTo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ptrs := make([]*int, 1000)
+		for j := range ptrs {
+			ptrs[j] = To(0)
+		}
+	}
+}
+
+// Benchmark FromSliceParallel against FromSlice on a very large slice
+func BenchmarkFromSliceHuge(b *testing.B) {
+	ptrs := ToSlice(make([]int, 1<<20))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = FromSlice(ptrs)
+	}
+}
+
+func BenchmarkFromSliceParallelHuge(b *testing.B) {
+	ptrs := ToSlice(make([]int, 1<<20))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = FromSliceParallel(ptrs, 0)
+	}
+}
+
+// Benchmark Arena against To
+func BenchmarkArenaTo(b *testing.B) {
+	a := NewArena[int]()
+	var sink *int
+	for i := 0; i < b.N; i++ {
+		sink = a.To(i)
+	}
+	_ = sink
+}
+
+func BenchmarkToEscaping(b *testing.B) {
+	var sink *int
+	for i := 0; i < b.N; i++ {
+		sink = To(i)
+	}
+	_ = sink
+}
+
+// Benchmark Pool Get/Put cycle
+func BenchmarkPool(b *testing.B) {
+	type payload struct {
+		ID   int
+		Name string
+		Data [8]int64
+	}
+	var pool Pool[payload]
+	var sink *payload
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := pool.Get()
+		p.ID = i
+		sink = p
+		pool.Put(p)
+	}
+	_ = sink
+}
@@ -0,0 +1,50 @@
+package ptr
+
+// Chain wraps a pointer to allow Map, Filter, and FlatMap to be chained
+// so pipelines read top-to-bottom. Create one with Of.
+//
+// Because Go methods cannot declare their own type parameters, the chained
+// Map and FlatMap keep the value type T. To change the type, use the
+// package-level Map or FlatMap on the result of Ptr.
+//
+// Example:
+//
+//	name := ptr.Of(input).
+//	    Map(strings.TrimSpace).
+//	    Filter(func(s string) bool { return s != "" }).
+//	    OrElse("anonymous")
+type Chain[T any] struct {
+	p *T
+}
+
+// Of wraps the pointer in a Chain.
+// A nil pointer produces an empty chain on which all operations are no-ops.
+func Of[T any](p *T) Chain[T] {
+	return Chain[T]{p: p}
+}
+
+// Map applies fn to the value if present. See the package-level Map.
+func (c Chain[T]) Map(fn func(T) T) Chain[T] {
+	return Chain[T]{p: Map(c.p, fn)}
+}
+
+// Filter keeps the value only if predicate returns true. See the package-level Filter.
+func (c Chain[T]) Filter(predicate func(T) bool) Chain[T] {
+	return Chain[T]{p: Filter(c.p, predicate)}
+}
+
+// FlatMap applies a pointer-returning fn to the value if present.
+// See the package-level FlatMap.
+func (c Chain[T]) FlatMap(fn func(T) *T) Chain[T] {
+	return Chain[T]{p: FlatMap(c.p, fn)}
+}
+
+// OrElse returns the value if present, otherwise defaultValue.
+func (c Chain[T]) OrElse(defaultValue T) T {
+	return FromOr(c.p, defaultValue)
+}
+
+// Ptr returns the underlying pointer, which is nil if the chain is empty.
+func (c Chain[T]) Ptr() *T {
+	return c.p
+}
@@ -0,0 +1,67 @@
+package ptr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	notEmpty := func(s string) bool { return s != "" }
+
+	t.Run("value flows through", func(t *testing.T) {
+		got := Of(To("  alice  ")).
+			Map(strings.TrimSpace).
+			Filter(notEmpty).
+			Map(strings.ToUpper).
+			OrElse("anonymous")
+		if got != "ALICE" {
+			t.Errorf("expected ALICE, got %q", got)
+		}
+	})
+
+	t.Run("filtered out", func(t *testing.T) {
+		got := Of(To("   ")).
+			Map(strings.TrimSpace).
+			Filter(notEmpty).
+			OrElse("anonymous")
+		if got != "anonymous" {
+			t.Errorf("expected anonymous, got %q", got)
+		}
+	})
+
+	t.Run("nil input skips functions", func(t *testing.T) {
+		called := false
+		c := Of[int](nil).Map(func(v int) int {
+			called = true
+			return v
+		})
+		if c.Ptr() != nil {
+			t.Error("expected nil")
+		}
+		if called {
+			t.Error("function should not be called for nil pointer")
+		}
+	})
+
+	t.Run("flat map", func(t *testing.T) {
+		half := func(v int) *int {
+			if v%2 != 0 {
+				return nil
+			}
+			return To(v / 2)
+		}
+		if got := Of(To(8)).FlatMap(half).FlatMap(half).Ptr(); got == nil || *got != 2 {
+			t.Errorf("expected pointer to 2, got %v", got)
+		}
+		if got := Of(To(6)).FlatMap(half).FlatMap(half).Ptr(); got != nil {
+			t.Errorf("expected nil, got %v", *got)
+		}
+	})
+
+	t.Run("ptr returns original", func(t *testing.T) {
+		p := To(1)
+		if Of(p).Ptr() != p {
+			t.Error("expected same pointer")
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package ptr
+
+// Ordered is a constraint that permits any ordered type: any type that
+// supports the operators < <= >= >. It matches cmp.Ordered from Go 1.21,
+// declared here so the package keeps supporting Go 1.18.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
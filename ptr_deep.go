@@ -0,0 +1,114 @@
+package ptr
+
+import "reflect"
+
+// DeepCopy creates a new pointer with a deep copy of the value.
+// Unlike Copy, nested pointers, slices, maps, arrays, and interface values
+// are cloned recursively, so the result shares no mutable memory with the
+// input. Cyclic pointer structures are preserved. Unexported struct fields,
+// channels, and functions are copied shallowly.
+// Returns nil if the input pointer is nil.
+//
+// Example:
+//
+//	type Config struct {
+//	    Tags []string
+//	}
+//	original := ptr.To(Config{Tags: []string{"a"}})
+//	snapshot := ptr.DeepCopy(original)
+//	original.Tags[0] = "b"  // snapshot.Tags[0] is still "a"
+func DeepCopy[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	c := deepCopyValue(reflect.ValueOf(p), map[seenKey]reflect.Value{})
+	return c.Interface().(*T)
+}
+
+// seenKey identifies a copied pointer. The type is part of the key because
+// pointers of different types can share an address, such as a pointer to a
+// struct and a pointer to its first field, or pointers to zero-size values.
+type seenKey struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+// deepCopyValue returns a recursive copy of v. The seen map tracks pointers
+// that have already been copied so that cycles terminate.
+func deepCopyValue(v reflect.Value, seen map[seenKey]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		key := seenKey{v.Type(), v.Pointer()}
+		if c, ok := seen[key]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[key] = c
+		c.Elem().Set(deepCopyValue(v.Elem(), seen))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i), seen))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value(), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopyValue(v.Index(i), seen))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				c.Field(i).Set(deepCopyValue(v.Field(i), seen))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem(), seen))
+		return c
+	default:
+		return v
+	}
+}
+
+// DeepEqual returns true if both pointers point to deeply equal values,
+// as defined by reflect.DeepEqual. Unlike Equal, T does not need to be
+// comparable, so it works for structs containing slices or maps.
+// Returns true if both pointers are nil.
+// Returns false if only one pointer is nil.
+//
+// Example:
+//
+//	a := ptr.To([]int{1, 2})
+//	b := ptr.To([]int{1, 2})
+//	ptr.DeepEqual(a, b)  // true
+func DeepEqual[T any](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return reflect.DeepEqual(*a, *b)
+}
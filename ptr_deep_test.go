@@ -0,0 +1,143 @@
+package ptr
+
+import "testing"
+
+type deepNode struct {
+	Name     string
+	Tags     []string
+	Attrs    map[string]*int
+	Child    *deepNode
+	Any      any
+	Matrix   [2][]int
+	internal []int
+}
+
+func TestDeepCopy(t *testing.T) {
+	t.Run("nil pointer", func(t *testing.T) {
+		if DeepCopy[deepNode](nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+
+	t.Run("scalar", func(t *testing.T) {
+		p := To(42)
+		c := DeepCopy(p)
+		if c == p || *c != 42 {
+			t.Errorf("expected distinct pointer to 42, got %v", c)
+		}
+	})
+
+	t.Run("nested values are independent", func(t *testing.T) {
+		original := &deepNode{
+			Name:   "root",
+			Tags:   []string{"a", "b"},
+			Attrs:  map[string]*int{"x": To(1), "nil": nil},
+			Child:  &deepNode{Name: "child", Tags: []string{"c"}},
+			Any:    []int{1, 2},
+			Matrix: [2][]int{{1}, {2}},
+		}
+		c := DeepCopy(original)
+
+		original.Tags[0] = "changed"
+		*original.Attrs["x"] = 100
+		original.Child.Tags[0] = "changed"
+		original.Any.([]int)[0] = 100
+		original.Matrix[0][0] = 100
+
+		if c.Name != "root" || c.Tags[0] != "a" {
+			t.Errorf("unexpected top-level copy: %+v", c)
+		}
+		if *c.Attrs["x"] != 1 {
+			t.Errorf("expected map value 1, got %d", *c.Attrs["x"])
+		}
+		if v, ok := c.Attrs["nil"]; !ok || v != nil {
+			t.Error("expected nil map entry to be preserved")
+		}
+		if c.Child == original.Child || c.Child.Tags[0] != "c" {
+			t.Error("expected child to be deep copied")
+		}
+		if c.Any.([]int)[0] != 1 {
+			t.Error("expected interface value to be deep copied")
+		}
+		if c.Matrix[0][0] != 1 {
+			t.Error("expected array elements to be deep copied")
+		}
+	})
+
+	t.Run("nil collections stay nil", func(t *testing.T) {
+		c := DeepCopy(&deepNode{})
+		if c.Tags != nil || c.Attrs != nil || c.Child != nil || c.Any != nil {
+			t.Errorf("expected nil fields, got %+v", c)
+		}
+	})
+
+	t.Run("unexported fields are shallow", func(t *testing.T) {
+		original := &deepNode{internal: []int{1}}
+		c := DeepCopy(original)
+		original.internal[0] = 2
+		if c.internal[0] != 2 {
+			t.Error("expected unexported field to share memory")
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		a := &deepNode{Name: "a"}
+		b := &deepNode{Name: "b", Child: a}
+		a.Child = b
+		c := DeepCopy(a)
+		if c == a || c.Child == b {
+			t.Error("expected new nodes")
+		}
+		if c.Child.Child != c {
+			t.Error("expected cycle to be preserved")
+		}
+	})
+
+	t.Run("pointers sharing an address", func(t *testing.T) {
+		type inner struct {
+			N int
+		}
+		type outer struct {
+			In *inner
+			N  *int
+			A  *struct{}
+			B  *[0]int
+		}
+		in := &inner{N: 1}
+		original := &outer{In: in, N: &in.N, A: &struct{}{}, B: &[0]int{}}
+		c := DeepCopy(original)
+		if c.In == in || c.In.N != 1 || c.N == &in.N || *c.N != 1 {
+			t.Errorf("unexpected copy: %+v", c)
+		}
+	})
+}
+
+func TestDeepEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *deepNode
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"first nil", nil, &deepNode{}, false},
+		{"second nil", &deepNode{}, nil, false},
+		{"equal", &deepNode{Tags: []string{"a"}, Attrs: map[string]*int{"x": To(1)}}, &deepNode{Tags: []string{"a"}, Attrs: map[string]*int{"x": To(1)}}, true},
+		{"different slice", &deepNode{Tags: []string{"a"}}, &deepNode{Tags: []string{"b"}}, false},
+		{"different map value", &deepNode{Attrs: map[string]*int{"x": To(1)}}, &deepNode{Attrs: map[string]*int{"x": To(2)}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeepEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("DeepEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("same pointer", func(t *testing.T) {
+		p := To([]int{1})
+		if !DeepEqual(p, p) {
+			t.Error("expected true")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package ptr
+
+import "sync"
+
+// Guarded is an optional value shared between goroutines. All access is
+// serialized by an internal read-write mutex, so a Guarded replaces the
+// usual hand-written struct of a *T and a sync.RWMutex.
+//
+// The zero value is empty and ready to use. A Guarded must not be copied
+// after first use.
+//
+// Example:
+//
+//	var token ptr.Guarded[string]
+//
+//	token.Set("abc")
+//	if t, ok := token.Get(); ok {
+//	    useToken(t)
+//	}
+//	token.Clear()
+type Guarded[T any] struct {
+	mu sync.RWMutex
+	p  *T
+}
+
+// Get returns the value and true if one is set.
+// Otherwise it returns the zero value of T and false.
+func (g *Guarded[T]) Get() (T, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return Value(g.p)
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if no value is set.
+func (g *Guarded[T]) Ptr() *T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return Copy(g.p)
+}
+
+// Set stores v, replacing any previous value.
+func (g *Guarded[T]) Set(v T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.p = &v
+}
+
+// Update replaces the value with fn applied to it while holding the lock,
+// so read-modify-write sequences are atomic. Does nothing if no value is set.
+func (g *Guarded[T]) Update(fn func(T) T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	Modify(g.p, fn)
+}
+
+// Clear removes the value.
+func (g *Guarded[T]) Clear() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.p = nil
+}
@@ -0,0 +1,64 @@
+package ptr
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGuarded(t *testing.T) {
+	var g Guarded[string]
+
+	if v, ok := g.Get(); ok || v != "" {
+		t.Errorf("Get() on empty = %q, %v; want \"\", false", v, ok)
+	}
+	if g.Ptr() != nil {
+		t.Error("expected nil Ptr on empty")
+	}
+	g.Update(func(s string) string {
+		t.Error("Update should not call fn when empty")
+		return s
+	})
+
+	g.Set("abc")
+	if v, ok := g.Get(); !ok || v != "abc" {
+		t.Errorf("Get() = %q, %v; want abc, true", v, ok)
+	}
+
+	p := g.Ptr()
+	*p = "changed"
+	if v, _ := g.Get(); v != "abc" {
+		t.Errorf("expected Ptr to return a copy, got %q", v)
+	}
+
+	g.Update(func(s string) string { return s + "def" })
+	if v, _ := g.Get(); v != "abcdef" {
+		t.Errorf("expected abcdef after Update, got %q", v)
+	}
+
+	g.Clear()
+	if _, ok := g.Get(); ok {
+		t.Error("expected empty after Clear")
+	}
+}
+
+func TestGuardedConcurrent(t *testing.T) {
+	var g Guarded[int]
+	g.Set(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				g.Update(func(n int) int { return n + 1 })
+				g.Get()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := g.Get(); v != 800 {
+		t.Errorf("expected 800, got %d", v)
+	}
+}
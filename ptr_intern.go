@@ -0,0 +1,71 @@
+package ptr
+
+// Shared pointers to common constant values.
+//
+// These let hot paths avoid an allocation per call, for example when setting
+// boolean flags on millions of generated messages. Every use shares the same
+// pointer, so the values must never be modified through them: writing
+// *ptr.True = false would change the value seen by every other caller.
+// Use To or Bool when the caller may mutate the result.
+//
+// Example:
+//
+//	msg.Enabled = ptr.True  // no allocation
+var (
+	True        = To(true)
+	False       = To(false)
+	ZeroInt     = IntCached(0)
+	EmptyString = To("")
+)
+
+// Bounds of the small-integer cache used by IntCached and Int64Cached.
+const (
+	intCacheMin = -128
+	intCacheMax = 1024
+)
+
+// The caches are filled by their initializers rather than by init, so that
+// package-level variables such as ZeroInt, which are initialized before any
+// init function runs, already see the cached values.
+var (
+	intCache = func() (c [intCacheMax - intCacheMin + 1]int) {
+		for i := range c {
+			c[i] = i + intCacheMin
+		}
+		return c
+	}()
+	int64Cache = func() (c [intCacheMax - intCacheMin + 1]int64) {
+		for i := range c {
+			c[i] = int64(i + intCacheMin)
+		}
+		return c
+	}()
+)
+
+// IntCached returns a pointer to v, sharing a single pre-allocated pointer for
+// each value in the range -128 to 1024 and allocating only outside it.
+// As with the shared constants, the result must never be modified because
+// other callers may hold the same pointer.
+//
+// Example:
+//
+//	msg.Retries = ptr.IntCached(3)  // no allocation
+func IntCached(v int) *int {
+	if v >= intCacheMin && v <= intCacheMax {
+		return &intCache[v-intCacheMin]
+	}
+	// Allocating explicitly keeps v from escaping on the cached path.
+	p := new(int)
+	*p = v
+	return p
+}
+
+// Int64Cached is like IntCached for int64 values.
+func Int64Cached(v int64) *int64 {
+	if v >= intCacheMin && v <= intCacheMax {
+		return &int64Cache[v-intCacheMin]
+	}
+	p := new(int64)
+	*p = v
+	return p
+}
@@ -0,0 +1,57 @@
+package ptr
+
+import "testing"
+
+// Package-level variables are initialized before init functions run, so
+// these observe the caches exactly as other package-level variables do.
+var (
+	cachedAtInit   = *IntCached(5)
+	cached64AtInit = *Int64Cached(-5)
+)
+
+func TestInternedConstants(t *testing.T) {
+	if True == nil || !*True {
+		t.Error("expected True to point to true")
+	}
+	if False == nil || *False {
+		t.Error("expected False to point to false")
+	}
+	if ZeroInt == nil || *ZeroInt != 0 {
+		t.Error("expected ZeroInt to point to 0")
+	}
+	if EmptyString == nil || *EmptyString != "" {
+		t.Error("expected EmptyString to point to an empty string")
+	}
+}
+
+func TestIntCached(t *testing.T) {
+	tests := []int{-129, -128, -1, 0, 1, 1024, 1025}
+	for _, v := range tests {
+		p := IntCached(v)
+		if p == nil || *p != v {
+			t.Errorf("IntCached(%d) = %v, want pointer to %d", v, p, v)
+		}
+		p64 := Int64Cached(int64(v))
+		if p64 == nil || *p64 != int64(v) {
+			t.Errorf("Int64Cached(%d) = %v, want pointer to %d", v, p64, v)
+		}
+
+		cached := v >= -128 && v <= 1024
+		if shared := IntCached(v) == p; shared != cached {
+			t.Errorf("IntCached(%d) shared = %v, want %v", v, shared, cached)
+		}
+		if shared := Int64Cached(int64(v)) == p64; shared != cached {
+			t.Errorf("Int64Cached(%d) shared = %v, want %v", v, shared, cached)
+		}
+	}
+
+	if ZeroInt != IntCached(0) {
+		t.Error("expected ZeroInt to share the cached pointer for 0")
+	}
+}
+
+func TestIntCachedDuringInitialization(t *testing.T) {
+	if cachedAtInit != 5 || cached64AtInit != -5 {
+		t.Errorf("expected cached values during package initialization, got %d and %d", cachedAtInit, cached64AtInit)
+	}
+}
@@ -0,0 +1,97 @@
+//go:build go1.23
+
+package ptr
+
+import "iter"
+
+// NonNil returns a sequence that yields the dereferenced values of the
+// non-nil pointers in seq, skipping nil pointers. It brings the nil-safety of
+// the slice helpers to streaming code built on range-over-func iterators.
+//
+// Example:
+//
+//	for v := range ptr.NonNil(slices.Values(ptrs)) {
+//	    fmt.Println(v)  // only present values
+//	}
+func NonNil[T any](seq iter.Seq[*T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for p := range seq {
+			if p != nil && !yield(*p) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq returns a sequence that yields a pointer to each value in seq.
+// Each yielded pointer refers to its own copy of the value.
+//
+// Example:
+//
+//	for p := range ptr.ToSeq(slices.Values([]int{1, 2})) {
+//	    api.Send(p)  // *int
+//	}
+func ToSeq[T any](seq iter.Seq[T]) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for v := range seq {
+			if !yield(&v) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns a sequence that yields the dereferenced values of the
+// pointers in seq. If skipNil is true, nil pointers are skipped (see NonNil);
+// otherwise they are yielded as the zero value, like FromSlice.
+//
+// Example:
+//
+//	ptrs := []*int{ptr.To(1), nil}
+//	slices.Collect(ptr.FromSeq(slices.Values(ptrs), false))  // [1 0]
+//	slices.Collect(ptr.FromSeq(slices.Values(ptrs), true))   // [1]
+func FromSeq[T any](seq iter.Seq[*T], skipNil bool) iter.Seq[T] {
+	if skipNil {
+		return NonNil(seq)
+	}
+	return func(yield func(T) bool) {
+		for p := range seq {
+			if !yield(From(p)) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 returns a sequence over the entries of m whose values are non-nil,
+// yielding each key with its dereferenced value. Nil entries are skipped.
+// Like ranging over a map, the iteration order is not specified.
+//
+// Example:
+//
+//	for k, v := range ptr.ToSeq2(settings) {
+//	    fmt.Printf("%s=%v\n", k, v)
+//	}
+func ToSeq2[K comparable, V any](m map[K]*V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, p := range m {
+			if p != nil && !yield(k, *p) {
+				return
+			}
+		}
+	}
+}
+
+// CollectSeq2 collects the key-value pairs of seq into a new map of pointers.
+// If a key is yielded more than once, the last value wins.
+//
+// Example:
+//
+//	ptrs := ptr.CollectSeq2(maps.All(map[string]int{"a": 1}))  // map[string]*int
+func CollectSeq2[K comparable, V any](seq iter.Seq2[K, V]) map[K]*V {
+	result := make(map[K]*V)
+	for k, v := range seq {
+		result[k] = &v
+	}
+	return result
+}
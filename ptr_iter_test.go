@@ -0,0 +1,115 @@
+//go:build go1.23
+
+package ptr
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestNonNil(t *testing.T) {
+	t.Run("skips nils", func(t *testing.T) {
+		got := slices.Collect(NonNil(slices.Values([]*int{Int(1), nil, Int(0), nil, Int(3)})))
+		want := []int{1, 0, 3}
+		if !slices.Equal(got, want) {
+			t.Errorf("NonNil() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := slices.Collect(NonNil(slices.Values([]*int{nil}))); len(got) != 0 {
+			t.Errorf("NonNil() = %v, want empty", got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		var got []int
+		for v := range NonNil(slices.Values([]*int{Int(1), Int(2), Int(3)})) {
+			got = append(got, v)
+			if v == 2 {
+				break
+			}
+		}
+		if !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("got %v, want [1 2]", got)
+		}
+	})
+}
+
+func TestToSeq(t *testing.T) {
+	ptrs := slices.Collect(ToSeq(slices.Values([]int{1, 2, 3})))
+	if len(ptrs) != 3 {
+		t.Fatalf("ToSeq() yielded %d pointers, want 3", len(ptrs))
+	}
+	for i, p := range ptrs {
+		if p == nil || *p != i+1 {
+			t.Errorf("ToSeq()[%d] = %v, want %d", i, p, i+1)
+		}
+	}
+	if ptrs[0] == ptrs[1] {
+		t.Error("expected distinct pointers")
+	}
+
+	count := 0
+	for range ToSeq(slices.Values([]int{1, 2, 3})) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("expected early break, got %d iterations", count)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	input := []*int{Int(1), nil, Int(3)}
+
+	if got := slices.Collect(FromSeq(slices.Values(input), false)); !slices.Equal(got, []int{1, 0, 3}) {
+		t.Errorf("FromSeq(zero-fill) = %v, want [1 0 3]", got)
+	}
+	if got := slices.Collect(FromSeq(slices.Values(input), true)); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("FromSeq(skip) = %v, want [1 3]", got)
+	}
+
+	var got []int
+	for v := range FromSeq(slices.Values(input), false) {
+		got = append(got, v)
+		break
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Errorf("expected early break, got %v", got)
+	}
+}
+
+func TestToSeq2(t *testing.T) {
+	m := map[string]*int{"a": Int(1), "b": nil, "c": Int(0)}
+	got := maps.Collect(ToSeq2(m))
+	want := map[string]int{"a": 1, "c": 0}
+	if !maps.Equal(got, want) {
+		t.Errorf("ToSeq2() = %v, want %v", got, want)
+	}
+
+	count := 0
+	for range ToSeq2(map[string]*int{"a": Int(1), "b": Int(2)}) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("expected early break, got %d iterations", count)
+	}
+}
+
+func TestCollectSeq2(t *testing.T) {
+	got := CollectSeq2(maps.All(map[string]int{"a": 1, "b": 2}))
+	if len(got) != 2 || *got["a"] != 1 || *got["b"] != 2 {
+		t.Errorf("CollectSeq2() = %v", FromMap(got))
+	}
+	if got["a"] == got["b"] {
+		t.Error("expected distinct pointers")
+	}
+
+	round := CollectSeq2(ToSeq2(map[string]*int{"x": Int(5), "y": nil}))
+	if len(round) != 1 || *round["x"] != 5 {
+		t.Errorf("round trip = %v, want map[x:5]", FromMap(round))
+	}
+}
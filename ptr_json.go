@@ -0,0 +1,139 @@
+package ptr
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// omittableState describes which of the three states an Omittable is in.
+type omittableState uint8
+
+const (
+	omittableUndefined omittableState = iota
+	omittableNull
+	omittableValue
+)
+
+// Omittable is a tri-state optional value that distinguishes a field that is
+// absent (undefined) from one that is explicitly null and from one that holds
+// a value. It is intended for JSON PATCH payloads, where "field missing" and
+// "field set to null" mean different things.
+//
+// The zero value is undefined. When decoding JSON, a missing field stays
+// undefined, a null field becomes null, and any other value is decoded into T.
+// When encoding, undefined and null both marshal as null; tag the field with
+// `json:",omitzero"` (Go 1.24+) to omit undefined fields from the output.
+//
+// Example:
+//
+//	type UserPatch struct {
+//	    Name  ptr.Omittable[string] `json:"name,omitzero"`
+//	    Email ptr.Omittable[string] `json:"email,omitzero"`
+//	}
+//	var p UserPatch
+//	_ = json.Unmarshal([]byte(`{"email":null}`), &p)
+//	p.Name.IsUndefined()  // true
+//	p.Email.IsNull()      // true
+type Omittable[T any] struct {
+	value T
+	state omittableState
+}
+
+// OmittableValue returns an Omittable holding v.
+func OmittableValue[T any](v T) Omittable[T] {
+	return Omittable[T]{value: v, state: omittableValue}
+}
+
+// OmittableNull returns an Omittable that is explicitly null.
+func OmittableNull[T any]() Omittable[T] {
+	return Omittable[T]{state: omittableNull}
+}
+
+// OmittableFrom converts a pointer to an Omittable.
+// A nil pointer becomes an explicit null; otherwise the Omittable holds *p.
+func OmittableFrom[T any](p *T) Omittable[T] {
+	if p == nil {
+		return OmittableNull[T]()
+	}
+	return OmittableValue(*p)
+}
+
+// IsUndefined reports whether the value is absent.
+func (o Omittable[T]) IsUndefined() bool {
+	return o.state == omittableUndefined
+}
+
+// IsNull reports whether the value is explicitly null.
+func (o Omittable[T]) IsNull() bool {
+	return o.state == omittableNull
+}
+
+// IsValue reports whether a value is present.
+func (o Omittable[T]) IsValue() bool {
+	return o.state == omittableValue
+}
+
+// IsZero reports whether the value is undefined.
+// It allows encoding/json's omitzero option to drop undefined fields.
+func (o Omittable[T]) IsZero() bool {
+	return o.IsUndefined()
+}
+
+// Get returns the value and true if a value is present.
+// Otherwise it returns the zero value of T and false.
+func (o Omittable[T]) Get() (T, bool) {
+	if o.state != omittableValue {
+		var zero T
+		return zero, false
+	}
+	return o.value, true
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if the value is
+// undefined or null.
+func (o Omittable[T]) Ptr() *T {
+	if o.state != omittableValue {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// PtrPresent returns the value as a pointer together with a presence flag.
+// The flag is false only when the value is undefined, so (nil, true) means
+// explicitly null and (non-nil, true) means a value was provided.
+//
+// Example:
+//
+//	if email, present := patch.Email.PtrPresent(); present {
+//	    user.Email = email  // may clear the field when email is nil
+//	}
+func (o Omittable[T]) PtrPresent() (*T, bool) {
+	return o.Ptr(), o.state != omittableUndefined
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Undefined and null values are encoded as null.
+func (o Omittable[T]) MarshalJSON() ([]byte, error) {
+	if o.state != omittableValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It is only called for fields present in the input, so the result is
+// either null or a value.
+func (o *Omittable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		var zero T
+		o.value, o.state = zero, omittableNull
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.value, o.state = v, omittableValue
+	return nil
+}
@@ -0,0 +1,117 @@
+package ptr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOmittableStates(t *testing.T) {
+	tests := []struct {
+		name      string
+		o         Omittable[int]
+		undefined bool
+		null      bool
+		value     bool
+	}{
+		{"zero value", Omittable[int]{}, true, false, false},
+		{"null", OmittableNull[int](), false, true, false},
+		{"value", OmittableValue(42), false, false, true},
+		{"from nil", OmittableFrom[int](nil), false, true, false},
+		{"from pointer", OmittableFrom(To(42)), false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.IsUndefined(); got != tt.undefined {
+				t.Errorf("IsUndefined() = %v, want %v", got, tt.undefined)
+			}
+			if got := tt.o.IsZero(); got != tt.undefined {
+				t.Errorf("IsZero() = %v, want %v", got, tt.undefined)
+			}
+			if got := tt.o.IsNull(); got != tt.null {
+				t.Errorf("IsNull() = %v, want %v", got, tt.null)
+			}
+			if got := tt.o.IsValue(); got != tt.value {
+				t.Errorf("IsValue() = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
+
+func TestOmittableAccessors(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		o := OmittableValue("hello")
+		v, ok := o.Get()
+		if !ok || v != "hello" {
+			t.Errorf("Get() = %q, %v; want hello, true", v, ok)
+		}
+		p, present := o.PtrPresent()
+		if !present || p == nil || *p != "hello" {
+			t.Errorf("PtrPresent() = %v, %v; want pointer to hello, true", p, present)
+		}
+	})
+
+	t.Run("null", func(t *testing.T) {
+		o := OmittableNull[string]()
+		if v, ok := o.Get(); ok || v != "" {
+			t.Errorf("Get() = %q, %v; want \"\", false", v, ok)
+		}
+		p, present := o.PtrPresent()
+		if !present || p != nil {
+			t.Errorf("PtrPresent() = %v, %v; want nil, true", p, present)
+		}
+	})
+
+	t.Run("undefined", func(t *testing.T) {
+		var o Omittable[string]
+		if o.Ptr() != nil {
+			t.Error("expected nil Ptr")
+		}
+		if _, present := o.PtrPresent(); present {
+			t.Error("expected not present")
+		}
+	})
+}
+
+func TestOmittableJSON(t *testing.T) {
+	type patch struct {
+		Name  Omittable[string] `json:"name"`
+		Email Omittable[string] `json:"email"`
+		Age   Omittable[int]    `json:"age"`
+	}
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var p patch
+		if err := json.Unmarshal([]byte(`{"email": null, "age": 30}`), &p); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !p.Name.IsUndefined() {
+			t.Error("expected Name undefined")
+		}
+		if !p.Email.IsNull() {
+			t.Error("expected Email null")
+		}
+		if v, ok := p.Age.Get(); !ok || v != 30 {
+			t.Errorf("expected Age 30, got %d, %v", v, ok)
+		}
+	})
+
+	t.Run("unmarshal error", func(t *testing.T) {
+		var p patch
+		if err := json.Unmarshal([]byte(`{"age": "thirty"}`), &p); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		p := patch{Email: OmittableNull[string](), Age: OmittableValue(30)}
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"name":null,"email":null,"age":30}`
+		if string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package ptr
+
+import "sync"
+
+// LazyValue holds a value that is computed on first access. Create one with
+// Lazy. It is safe for concurrent use.
+type LazyValue[T any] struct {
+	once sync.Once
+	init func() T
+	p    *T
+}
+
+// Lazy returns a LazyValue whose Get calls init exactly once, on first use,
+// and returns a pointer to the result on every call.
+//
+// Example:
+//
+//	var client = ptr.Lazy(func() Client {
+//	    return NewClient(os.Getenv("API_URL"))
+//	})
+//
+//	func handler() {
+//	    c := client.Get()  // constructed on first call only
+//	}
+func Lazy[T any](init func() T) *LazyValue[T] {
+	return &LazyValue[T]{init: init}
+}
+
+// Get returns a pointer to the value, computing it on the first call.
+// All calls return the same pointer. If init panics, Get panics and later
+// calls return a pointer to the zero value, matching sync.Once.
+func (l *LazyValue[T]) Get() *T {
+	l.once.Do(func() {
+		p := new(T)
+		l.p = p
+		*p = l.init()
+		l.init = nil
+	})
+	return l.p
+}
+
+// Memoize returns a function that behaves like Map with fn, but caches the
+// result for each distinct input value so fn runs at most once per value.
+// The returned function returns nil for a nil input and a new pointer to the
+// cached result otherwise, so callers may modify it freely.
+//
+// The returned function is safe for concurrent use. Concurrent first calls
+// with the same value may each run fn; one result is kept. The cache is
+// never evicted, so use it only for a bounded set of inputs.
+//
+// Example:
+//
+//	lookupCountry := ptr.Memoize(func(code string) Country {
+//	    return fetchCountry(code)  // expensive
+//	})
+//	c := lookupCountry(user.CountryCode)  // nil if CountryCode is nil
+func Memoize[T comparable, R any](fn func(T) R) func(*T) *R {
+	var mu sync.Mutex
+	cache := make(map[T]R)
+	return func(p *T) *R {
+		if p == nil {
+			return nil
+		}
+		mu.Lock()
+		r, ok := cache[*p]
+		mu.Unlock()
+		if !ok {
+			r = fn(*p)
+			mu.Lock()
+			if cached, exists := cache[*p]; exists {
+				r = cached
+			} else {
+				cache[*p] = r
+			}
+			mu.Unlock()
+		}
+		return &r
+	}
+}
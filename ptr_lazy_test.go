@@ -0,0 +1,102 @@
+package ptr
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	l := Lazy(func() string {
+		calls++
+		return "ready"
+	})
+	if calls != 0 {
+		t.Fatal("expected init not to run before Get")
+	}
+
+	first := l.Get()
+	if first == nil || *first != "ready" {
+		t.Fatalf("Get() = %v, want pointer to ready", first)
+	}
+	if second := l.Get(); second != first {
+		t.Error("expected Get to return the same pointer")
+	}
+	if calls != 1 {
+		t.Errorf("expected init to run once, got %d", calls)
+	}
+}
+
+func TestLazyConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	l := Lazy(func() int {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return 42
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := l.Get(); *v != 42 {
+				t.Errorf("expected 42, got %d", *v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected init to run once, got %d", calls)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	calls := map[string]int{}
+	length := Memoize(func(s string) int {
+		calls[s]++
+		return len(s)
+	})
+
+	if length(nil) != nil {
+		t.Error("expected nil for nil input")
+	}
+
+	first := length(To("abc"))
+	if first == nil || *first != 3 {
+		t.Fatalf("expected pointer to 3, got %v", first)
+	}
+	*first = 100
+
+	second := length(To("abc"))
+	if second == nil || *second != 3 {
+		t.Errorf("expected cached result 3 unaffected by caller writes, got %v", second)
+	}
+	if *length(To("hello")) != 5 {
+		t.Error("expected 5 for hello")
+	}
+	if calls["abc"] != 1 || calls["hello"] != 1 {
+		t.Errorf("expected fn to run once per value, got %v", calls)
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	double := Memoize(func(n int) int { return n * 2 })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if got := double(To(j)); *got != j*2 {
+					t.Errorf("expected %d, got %d", j*2, *got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -40,6 +40,238 @@ func FromMap[T any](ptrs map[string]*T) map[string]T {
 	return result
 }
 
+// FromMapSkipNil converts a map with pointer value type *T to a map with
+// value type T, omitting entries whose value is nil instead of converting
+// them to zero values. Returns nil if the input map is nil.
+//
+// Example:
+//
+//	ptrs := map[string]*int{"a": ptr.To(1), "b": nil, "c": ptr.To(0)}
+//	values := ptr.FromMapSkipNil(ptrs)  // map[string]int{"a": 1, "c": 0}
+func FromMapSkipNil[K comparable, T any](ptrs map[K]*T) map[K]T {
+	if ptrs == nil {
+		return nil
+	}
+	result := make(map[K]T, len(ptrs))
+	for k, p := range ptrs {
+		if p != nil {
+			result[k] = *p
+		}
+	}
+	return result
+}
+
+// Lookup returns a pointer to a copy of the value stored under key k, or nil
+// if the key is not present. It turns the comma-ok idiom into a pointer that
+// flows straight into FromOr, Map, and friends.
+//
+// Example:
+//
+//	ports := map[string]int{"http": 80}
+//	ptr.FromOr(ptr.Lookup(ports, "https"), 443)  // 443
+func Lookup[K comparable, V any](m map[K]V, k K) *V {
+	v, ok := m[k]
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// EqualMaps returns true if both maps have the same set of keys and the values
+// for each key are equal according to Equal: two nil pointers are equal, and a
+// nil pointer never equals a non-nil one. A key mapped to nil is not the same
+// as a missing key. A nil map and an empty map are considered equal.
+//
+// Example:
+//
+//	a := map[string]*int{"x": ptr.To(1), "y": nil}
+//	b := map[string]*int{"x": ptr.To(1), "y": nil}
+//	ptr.EqualMaps(a, b)  // true
+func EqualMaps[K, V comparable](a, b map[K]*V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !Equal(av, bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapValues applies a transformation function to every non-nil value in the
+// map. Keys with nil values are kept and map to nil in the result.
+// Returns nil if the input map is nil.
+//
+// Example:
+//
+//	names := map[string]*string{"a": ptr.To("alice"), "b": nil}
+//	lengths := ptr.MapValues(names, func(s string) int { return len(s) })
+//	// map[a:5 b:nil]
+func MapValues[K comparable, T, R any](m map[K]*T, fn func(T) R) map[K]*R {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]*R, len(m))
+	for k, p := range m {
+		result[k] = Map(p, fn)
+	}
+	return result
+}
+
+// FilterMapEntries returns a new map containing only the entries with a
+// non-nil value that satisfy the predicate. The predicate is not called for
+// nil values. Returns nil if the input map is nil.
+//
+// Example:
+//
+//	patch := map[string]*string{"name": ptr.To("alice"), "email": nil, "note": ptr.To("")}
+//	sparse := ptr.FilterMapEntries(patch, func(k, v string) bool { return v != "" })
+//	// map[name:alice]
+func FilterMapEntries[K comparable, T any](m map[K]*T, predicate func(K, T) bool) map[K]*T {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]*T)
+	for k, p := range m {
+		if p != nil && predicate(k, *p) {
+			result[k] = p
+		}
+	}
+	return result
+}
+
+// NonNilKeys returns the keys of the map whose values are non-nil,
+// in no particular order.
+//
+// Example:
+//
+//	settings := map[string]*int{"timeout": ptr.To(30), "retries": nil}
+//	ptr.NonNilKeys(settings)  // [timeout]
+func NonNilKeys[K comparable, T any](m map[K]*T) []K {
+	var keys []K
+	for k, p := range m {
+		if p != nil {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// NilKeys returns the keys of the map whose values are nil,
+// in no particular order.
+//
+// Example:
+//
+//	settings := map[string]*int{"timeout": ptr.To(30), "retries": nil}
+//	ptr.NilKeys(settings)  // [retries]
+func NilKeys[K comparable, T any](m map[K]*T) []K {
+	var keys []K
+	for k, p := range m {
+		if p == nil {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// MergeStrategy selects how MergeMaps resolves keys present in both maps.
+type MergeStrategy int
+
+const (
+	// PreferNonNil keeps the source value unless it is nil, in which case the
+	// destination value is kept. This layers sparse patches over a base.
+	PreferNonNil MergeStrategy = iota
+	// PreferSrc always takes the source value, even when it is nil.
+	PreferSrc
+)
+
+// MergeMaps copies the entries of src into dst and returns dst.
+// Keys only present in src are added. For keys present in both maps the
+// strategy decides which value wins; use MergeMapsFunc for a custom resolver.
+// If dst is nil and src is not empty, a new map is allocated and returned.
+//
+// Example:
+//
+//	base := map[string]*int{"timeout": ptr.To(30), "retries": ptr.To(3)}
+//	patch := map[string]*int{"timeout": ptr.To(60), "retries": nil}
+//	merged := ptr.MergeMaps(base, patch, ptr.PreferNonNil)
+//	// timeout is 60, retries is still 3
+func MergeMaps[K comparable, T any](dst, src map[K]*T, strategy MergeStrategy) map[K]*T {
+	return MergeMapsFunc(dst, src, func(_ K, d, s *T) *T {
+		if strategy == PreferNonNil {
+			return Or(s, d)
+		}
+		return s
+	})
+}
+
+// MergeMapsFunc copies the entries of src into dst and returns dst, calling
+// resolve to pick the value for each key present in both maps. Keys only
+// present in src are added without calling resolve.
+// If dst is nil and src is not empty, a new map is allocated and returned.
+//
+// Example:
+//
+//	merged := ptr.MergeMapsFunc(a, b, func(key string, dst, src *int) *int {
+//	    return ptr.Max(dst, src)
+//	})
+func MergeMapsFunc[K comparable, T any](dst, src map[K]*T, resolve func(key K, dst, src *T) *T) map[K]*T {
+	if dst == nil && len(src) > 0 {
+		dst = make(map[K]*T, len(src))
+	}
+	for k, s := range src {
+		if d, ok := dst[k]; ok {
+			dst[k] = resolve(k, d, s)
+		} else {
+			dst[k] = s
+		}
+	}
+	return dst
+}
+
+// MapDiff describes the differences between two maps, as returned by DiffMaps.
+// The key slices are in no particular order.
+type MapDiff[K comparable] struct {
+	Added   []K // keys present only in the new map
+	Removed []K // keys present only in the old map
+	Changed []K // keys present in both maps whose values are not Equal
+}
+
+// IsEmpty reports whether the diff contains no changes.
+func (d MapDiff[K]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffMaps compares two maps of pointers and reports which keys were added,
+// removed, or changed. Values are compared with Equal, so a key whose value
+// goes from nil to non-nil (or back) counts as changed.
+//
+// Example:
+//
+//	old := map[string]*int{"timeout": ptr.To(30), "retries": ptr.To(3)}
+//	cur := map[string]*int{"timeout": ptr.To(60), "debug": nil}
+//	d := ptr.DiffMaps(old, cur)
+//	// d.Added: [debug], d.Removed: [retries], d.Changed: [timeout]
+func DiffMaps[K, V comparable](oldMap, newMap map[K]*V) MapDiff[K] {
+	var d MapDiff[K]
+	for k, ov := range oldMap {
+		nv, ok := newMap[k]
+		if !ok {
+			d.Removed = append(d.Removed, k)
+		} else if !Equal(ov, nv) {
+			d.Changed = append(d.Changed, k)
+		}
+	}
+	for k := range newMap {
+		if _, ok := oldMap[k]; !ok {
+			d.Added = append(d.Added, k)
+		}
+	}
+	return d
+}
+
 // StringMap converts a map of strings to a map of string pointers.
 func StringMap(vs map[string]string) map[string]*string {
 	return ToMap(vs)
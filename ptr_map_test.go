@@ -1,6 +1,7 @@
 package ptr
 
 import (
+	"sort"
 	"testing"
 	"time"
 )
@@ -474,3 +475,275 @@ func TestToByteMap(t *testing.T) {
 		}
 	}
 }
+
+func TestEqualMaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b map[string]*int
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"nil and empty", nil, map[string]*int{}, true},
+		{"equal values", map[string]*int{"a": Int(1), "b": nil}, map[string]*int{"a": Int(1), "b": nil}, true},
+		{"different values", map[string]*int{"a": Int(1)}, map[string]*int{"a": Int(2)}, false},
+		{"nil vs non-nil value", map[string]*int{"a": nil}, map[string]*int{"a": Int(0)}, false},
+		{"different keys", map[string]*int{"a": nil}, map[string]*int{"b": nil}, false},
+		{"different sizes", map[string]*int{"a": Int(1)}, map[string]*int{"a": Int(1), "b": Int(2)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualMaps(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualMaps() = %v, want %v", got, tt.want)
+			}
+			if got := EqualMaps(tt.b, tt.a); got != tt.want {
+				t.Errorf("EqualMaps() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("non-string keys", func(t *testing.T) {
+		if !EqualMaps(map[int]*string{1: String("a")}, map[int]*string{1: String("a")}) {
+			t.Error("expected true")
+		}
+	})
+}
+
+func TestMapValues(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	t.Run("keeps nil entries", func(t *testing.T) {
+		result := MapValues(map[string]*string{"a": String("alice"), "b": nil}, length)
+		if len(result) != 2 {
+			t.Fatalf("MapValues() length = %d, want 2", len(result))
+		}
+		if result["a"] == nil || *result["a"] != 5 {
+			t.Errorf("MapValues()[a] = %v, want 5", result["a"])
+		}
+		if v, ok := result["b"]; !ok || v != nil {
+			t.Errorf("MapValues()[b] = %v, %v; want nil, true", v, ok)
+		}
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		if result := MapValues[string](nil, length); result != nil {
+			t.Errorf("MapValues(nil) = %v, want nil", result)
+		}
+	})
+}
+
+func TestFilterMapEntries(t *testing.T) {
+	nonEmpty := func(k, v string) bool { return v != "" }
+
+	t.Run("drops nil and failing entries", func(t *testing.T) {
+		name := String("alice")
+		input := map[string]*string{"name": name, "email": nil, "note": String("")}
+		result := FilterMapEntries(input, nonEmpty)
+		if len(result) != 1 || result["name"] != name {
+			t.Errorf("FilterMapEntries() = %v, want map[name:alice]", result)
+		}
+		if len(input) != 3 {
+			t.Error("expected input to be unmodified")
+		}
+	})
+
+	t.Run("predicate receives key", func(t *testing.T) {
+		result := FilterMapEntries(map[string]*int{"keep": Int(1), "drop": Int(2)}, func(k string, v int) bool {
+			return k == "keep"
+		})
+		if len(result) != 1 || result["keep"] == nil {
+			t.Errorf("FilterMapEntries() = %v, want only keep", result)
+		}
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		if result := FilterMapEntries(nil, nonEmpty); result != nil {
+			t.Errorf("FilterMapEntries(nil) = %v, want nil", result)
+		}
+	})
+}
+
+func TestFromMapSkipNil(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]*int
+		want  map[string]int
+	}{
+		{"nil map", nil, nil},
+		{"empty map", map[string]*int{}, map[string]int{}},
+		{"all nil", map[string]*int{"a": nil}, map[string]int{}},
+		{"keeps zero values", map[string]*int{"a": Int(1), "b": nil, "c": Int(0)}, map[string]int{"a": 1, "c": 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FromMapSkipNil(tt.input)
+			if (tt.want == nil) != (result == nil) {
+				t.Fatalf("FromMapSkipNil(%v) = %v, want %v", tt.input, result, tt.want)
+			}
+			if len(result) != len(tt.want) {
+				t.Fatalf("FromMapSkipNil(%v) length = %d, want %d", tt.input, len(result), len(tt.want))
+			}
+			for k, v := range tt.want {
+				if got, ok := result[k]; !ok || got != v {
+					t.Errorf("FromMapSkipNil(%v)[%q] = %v, %v; want %v", tt.input, k, got, ok, v)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeMaps(t *testing.T) {
+	t.Run("prefer non-nil", func(t *testing.T) {
+		base := map[string]*int{"timeout": Int(30), "retries": Int(3)}
+		patch := map[string]*int{"timeout": Int(60), "retries": nil, "debug": Int(1)}
+		result := MergeMaps(base, patch, PreferNonNil)
+		want := map[string]*int{"timeout": Int(60), "retries": Int(3), "debug": Int(1)}
+		if !EqualMaps(result, want) {
+			t.Errorf("MergeMaps() = %v, want %v", FromMap(result), FromMap(want))
+		}
+		if !EqualMaps(base, want) {
+			t.Error("expected dst to be modified in place")
+		}
+	})
+
+	t.Run("prefer src", func(t *testing.T) {
+		base := map[string]*int{"timeout": Int(30), "retries": Int(3)}
+		patch := map[string]*int{"retries": nil}
+		result := MergeMaps(base, patch, PreferSrc)
+		if v, ok := result["retries"]; !ok || v != nil {
+			t.Errorf("expected retries to be nil, got %v", v)
+		}
+		if *result["timeout"] != 30 {
+			t.Errorf("expected timeout 30, got %d", *result["timeout"])
+		}
+	})
+
+	t.Run("nil dst", func(t *testing.T) {
+		result := MergeMaps(nil, map[string]*int{"a": Int(1)}, PreferNonNil)
+		if len(result) != 1 || *result["a"] != 1 {
+			t.Errorf("MergeMaps(nil, src) = %v", result)
+		}
+		if result := MergeMaps[string, int](nil, nil, PreferNonNil); result != nil {
+			t.Errorf("MergeMaps(nil, nil) = %v, want nil", result)
+		}
+	})
+}
+
+func TestMergeMapsFunc(t *testing.T) {
+	var resolved []string
+	dst := map[string]*int{"a": Int(1), "b": Int(5)}
+	src := map[string]*int{"a": Int(3), "b": nil, "c": Int(7)}
+	result := MergeMapsFunc(dst, src, func(key string, d, s *int) *int {
+		resolved = append(resolved, key)
+		return Max(d, s)
+	})
+
+	want := map[string]*int{"a": Int(3), "b": Int(5), "c": Int(7)}
+	if !EqualMaps(result, want) {
+		t.Errorf("MergeMapsFunc() = %v, want %v", FromMap(result), FromMap(want))
+	}
+	if len(resolved) != 2 {
+		t.Errorf("expected resolve to be called for 2 conflicting keys, got %v", resolved)
+	}
+}
+
+func TestDiffMaps(t *testing.T) {
+	sorted := func(keys []string) []string {
+		out := append([]string(nil), keys...)
+		sort.Strings(out)
+		return out
+	}
+	equalKeys := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("added removed changed", func(t *testing.T) {
+		oldMap := map[string]*int{"timeout": Int(30), "retries": Int(3), "same": Int(1), "cleared": Int(5)}
+		newMap := map[string]*int{"timeout": Int(60), "same": Int(1), "cleared": nil, "debug": nil}
+		d := DiffMaps(oldMap, newMap)
+		if got := sorted(d.Added); !equalKeys(got, []string{"debug"}) {
+			t.Errorf("Added = %v, want [debug]", got)
+		}
+		if got := sorted(d.Removed); !equalKeys(got, []string{"retries"}) {
+			t.Errorf("Removed = %v, want [retries]", got)
+		}
+		if got := sorted(d.Changed); !equalKeys(got, []string{"cleared", "timeout"}) {
+			t.Errorf("Changed = %v, want [cleared timeout]", got)
+		}
+		if d.IsEmpty() {
+			t.Error("expected non-empty diff")
+		}
+	})
+
+	t.Run("identical", func(t *testing.T) {
+		d := DiffMaps(map[string]*int{"a": Int(1), "b": nil}, map[string]*int{"a": Int(1), "b": nil})
+		if !d.IsEmpty() {
+			t.Errorf("expected empty diff, got %+v", d)
+		}
+	})
+
+	t.Run("nil maps", func(t *testing.T) {
+		if d := DiffMaps[string, int](nil, nil); !d.IsEmpty() {
+			t.Errorf("expected empty diff, got %+v", d)
+		}
+		d := DiffMaps(nil, map[string]*int{"a": nil})
+		if !equalKeys(d.Added, []string{"a"}) {
+			t.Errorf("Added = %v, want [a]", d.Added)
+		}
+	})
+}
+
+func TestNonNilKeys(t *testing.T) {
+	m := map[string]*int{"a": Int(1), "b": nil, "c": Int(0), "d": nil}
+
+	nonNil := NonNilKeys(m)
+	sort.Strings(nonNil)
+	if len(nonNil) != 2 || nonNil[0] != "a" || nonNil[1] != "c" {
+		t.Errorf("NonNilKeys() = %v, want [a c]", nonNil)
+	}
+
+	nilKeys := NilKeys(m)
+	sort.Strings(nilKeys)
+	if len(nilKeys) != 2 || nilKeys[0] != "b" || nilKeys[1] != "d" {
+		t.Errorf("NilKeys() = %v, want [b d]", nilKeys)
+	}
+
+	if keys := NonNilKeys[string, int](nil); keys != nil {
+		t.Errorf("NonNilKeys(nil) = %v, want nil", keys)
+	}
+	if keys := NilKeys[string, int](nil); keys != nil {
+		t.Errorf("NilKeys(nil) = %v, want nil", keys)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+
+	if p := Lookup(m, "a"); p == nil || *p != 1 {
+		t.Errorf("Lookup(a) = %v, want pointer to 1", p)
+	}
+	if p := Lookup(m, "zero"); p == nil || *p != 0 {
+		t.Errorf("Lookup(zero) = %v, want pointer to 0", p)
+	}
+	if p := Lookup(m, "missing"); p != nil {
+		t.Errorf("Lookup(missing) = %v, want nil", p)
+	}
+	if p := Lookup[string, int](nil, "a"); p != nil {
+		t.Errorf("Lookup on nil map = %v, want nil", p)
+	}
+
+	p := Lookup(m, "a")
+	*p = 100
+	if m["a"] != 1 {
+		t.Error("expected Lookup to return a copy")
+	}
+}
@@ -0,0 +1,75 @@
+package ptr
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Matcher matches pointer arguments by the value they point to rather than
+// by address. It has the same method set as gomock's Matcher interface, so
+// the matchers returned by PointsTo and IsNilPtr can be passed directly to
+// gomock expectations, and its Matches method can be wrapped with testify's
+// mock.MatchedBy or checked with assert.True.
+//
+// Example:
+//
+//	repo.EXPECT().Save(ptr.PointsTo(User{Name: "Alice"}))
+//	mockObj.On("SetLimit", mock.MatchedBy(ptr.PointsTo(10).Matches))
+//	assert.True(t, ptr.PointsTo(42).Matches(got))
+type Matcher interface {
+	// Matches reports whether x is matched.
+	Matches(x any) bool
+	// String describes what the matcher matches.
+	String() string
+}
+
+// PointsTo returns a Matcher that matches a non-nil *T whose pointee is
+// deeply equal to v. Values of any other type, including nil pointers, do
+// not match.
+//
+// Example:
+//
+//	m := ptr.PointsTo(42)
+//	m.Matches(ptr.Int(42))  // true
+//	m.Matches(ptr.Int(7))   // false
+//	m.Matches((*int)(nil))  // false
+func PointsTo[T any](v T) Matcher {
+	return pointsTo[T]{want: v}
+}
+
+type pointsTo[T any] struct {
+	want T
+}
+
+func (m pointsTo[T]) Matches(x any) bool {
+	p, ok := x.(*T)
+	return ok && p != nil && reflect.DeepEqual(*p, m.want)
+}
+
+func (m pointsTo[T]) String() string {
+	return fmt.Sprintf("points to %v (%T)", m.want, m.want)
+}
+
+// IsNilPtr returns a Matcher that matches nil pointers of any type, as well
+// as an untyped nil.
+//
+// Example:
+//
+//	repo.EXPECT().Update(gomock.Any(), ptr.IsNilPtr())
+func IsNilPtr() Matcher {
+	return isNilPtr{}
+}
+
+type isNilPtr struct{}
+
+func (isNilPtr) Matches(x any) bool {
+	if x == nil {
+		return true
+	}
+	v := reflect.ValueOf(x)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+func (isNilPtr) String() string {
+	return "is nil pointer"
+}
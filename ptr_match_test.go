@@ -0,0 +1,55 @@
+package ptr
+
+import "testing"
+
+func TestPointsTo(t *testing.T) {
+	type user struct {
+		Name string
+		Tags []string
+	}
+
+	tests := []struct {
+		name string
+		m    Matcher
+		x    any
+		want bool
+	}{
+		{"equal value", PointsTo(42), Int(42), true},
+		{"different value", PointsTo(42), Int(7), false},
+		{"nil pointer", PointsTo(42), (*int)(nil), false},
+		{"untyped nil", PointsTo(42), nil, false},
+		{"value instead of pointer", PointsTo(42), 42, false},
+		{"different pointer type", PointsTo(42), Int64(42), false},
+		{"deep equal struct", PointsTo(user{"a", []string{"x"}}), &user{"a", []string{"x"}}, true},
+		{"different struct", PointsTo(user{"a", nil}), &user{"b", nil}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Matches(tt.x); got != tt.want {
+				t.Errorf("%s: Matches(%v) = %v, want %v", tt.m, tt.x, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := PointsTo(42).String(), "points to 42 (int)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestIsNilPtr(t *testing.T) {
+	m := IsNilPtr()
+	for _, x := range []any{nil, (*int)(nil), (*struct{})(nil)} {
+		if !m.Matches(x) {
+			t.Errorf("Matches(%#v) = false, want true", x)
+		}
+	}
+	for _, x := range []any{Int(0), 0, []int(nil), map[string]int(nil)} {
+		if m.Matches(x) {
+			t.Errorf("Matches(%#v) = true, want false", x)
+		}
+	}
+	if got, want := m.String(), "is nil pointer"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
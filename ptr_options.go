@@ -0,0 +1,114 @@
+package ptr
+
+// ConvertOption configures FromSliceWith and FromMapWith.
+type ConvertOption[T any] func(*convertConfig[T])
+
+// convertConfig holds the settings applied by ConvertOption values.
+type convertConfig[T any] struct {
+	skipNil    bool
+	hasDefault bool
+	def        T
+	capacity   int
+}
+
+func newConvertConfig[T any](opts []ConvertOption[T]) convertConfig[T] {
+	var cfg convertConfig[T]
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithSkipNil omits nil pointers from the result instead of converting them.
+// It takes precedence over WithDefault.
+func WithSkipNil[T any]() ConvertOption[T] {
+	return func(c *convertConfig[T]) {
+		c.skipNil = true
+	}
+}
+
+// WithDefault converts nil pointers to v instead of the zero value.
+func WithDefault[T any](v T) ConvertOption[T] {
+	return func(c *convertConfig[T]) {
+		c.def, c.hasDefault = v, true
+	}
+}
+
+// WithCapacity preallocates room for at least n elements in the result,
+// which avoids a reallocation when the caller appends to it afterwards.
+func WithCapacity[T any](n int) ConvertOption[T] {
+	return func(c *convertConfig[T]) {
+		c.capacity = n
+	}
+}
+
+// FromSliceWith converts a slice of pointers to a slice of values like
+// FromSlice, with the handling of nil pointers controlled by opts.
+// Without options it behaves exactly like FromSlice.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	ptrs := []*int{ptr.To(1), nil, ptr.To(3)}
+//	ptr.FromSliceWith(ptrs)                          // []int{1, 0, 3}
+//	ptr.FromSliceWith(ptrs, ptr.WithDefault(-1))     // []int{1, -1, 3}
+//	ptr.FromSliceWith(ptrs, ptr.WithSkipNil[int]())  // []int{1, 3}
+func FromSliceWith[T any](ptrs []*T, opts ...ConvertOption[T]) []T {
+	if ptrs == nil {
+		return nil
+	}
+	cfg := newConvertConfig(opts)
+	capacity := len(ptrs)
+	if cfg.capacity > capacity {
+		capacity = cfg.capacity
+	}
+	result := make([]T, 0, capacity)
+	for _, p := range ptrs {
+		switch {
+		case p != nil:
+			result = append(result, *p)
+		case cfg.skipNil:
+		case cfg.hasDefault:
+			result = append(result, cfg.def)
+		default:
+			var zero T
+			result = append(result, zero)
+		}
+	}
+	return result
+}
+
+// FromMapWith converts a map with pointer value type *T to a map with value
+// type T like FromMap, with the handling of nil pointers controlled by opts.
+// Without options it behaves exactly like FromMap.
+// Returns nil if the input map is nil.
+//
+// Example:
+//
+//	ptrs := map[string]*int{"a": ptr.To(1), "b": nil}
+//	ptr.FromMapWith(ptrs, ptr.WithDefault(-1))     // map[string]int{"a": 1, "b": -1}
+//	ptr.FromMapWith(ptrs, ptr.WithSkipNil[int]())  // map[string]int{"a": 1}
+func FromMapWith[T any](ptrs map[string]*T, opts ...ConvertOption[T]) map[string]T {
+	if ptrs == nil {
+		return nil
+	}
+	cfg := newConvertConfig(opts)
+	capacity := len(ptrs)
+	if cfg.capacity > capacity {
+		capacity = cfg.capacity
+	}
+	result := make(map[string]T, capacity)
+	for k, p := range ptrs {
+		switch {
+		case p != nil:
+			result[k] = *p
+		case cfg.skipNil:
+		case cfg.hasDefault:
+			result[k] = cfg.def
+		default:
+			var zero T
+			result[k] = zero
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package ptr
+
+import "testing"
+
+func TestFromSliceWith(t *testing.T) {
+	ptrs := []*int{To(1), nil, To(3)}
+
+	tests := []struct {
+		name string
+		opts []ConvertOption[int]
+		want []int
+	}{
+		{"no options", nil, []int{1, 0, 3}},
+		{"default", []ConvertOption[int]{WithDefault(-1)}, []int{1, -1, 3}},
+		{"skip nil", []ConvertOption[int]{WithSkipNil[int]()}, []int{1, 3}},
+		{"skip nil wins over default", []ConvertOption[int]{WithDefault(-1), WithSkipNil[int]()}, []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromSliceWith(ptrs, tt.opts...)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FromSliceWith() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("FromSliceWith() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("capacity", func(t *testing.T) {
+		got := FromSliceWith(ptrs, WithCapacity[int](10))
+		if len(got) != 3 || cap(got) != 10 {
+			t.Errorf("expected len 3 cap 10, got len %d cap %d", len(got), cap(got))
+		}
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		if FromSliceWith[int](nil, WithDefault(1)) != nil {
+			t.Error("expected nil for nil input")
+		}
+	})
+}
+
+func TestFromMapWith(t *testing.T) {
+	ptrs := map[string]*int{"a": To(1), "b": nil}
+
+	got := FromMapWith(ptrs)
+	if len(got) != 2 || got["a"] != 1 || got["b"] != 0 {
+		t.Errorf("FromMapWith() = %v, want map[a:1 b:0]", got)
+	}
+
+	got = FromMapWith(ptrs, WithDefault(-1))
+	if len(got) != 2 || got["a"] != 1 || got["b"] != -1 {
+		t.Errorf("FromMapWith(WithDefault) = %v, want map[a:1 b:-1]", got)
+	}
+
+	got = FromMapWith(ptrs, WithSkipNil[int](), WithCapacity[int](8))
+	if _, ok := got["b"]; len(got) != 1 || got["a"] != 1 || ok {
+		t.Errorf("FromMapWith(WithSkipNil) = %v, want map[a:1]", got)
+	}
+
+	if FromMapWith[int](nil) != nil {
+		t.Error("expected nil for nil input")
+	}
+}
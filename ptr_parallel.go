@@ -0,0 +1,141 @@
+package ptr
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ToSliceParallel is like ToSlice but splits the work across workers
+// goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// It only pays off for very large slices; for typical sizes ToSlice is faster.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	ptrs := ptr.ToSliceParallel(rows, 8)
+func ToSliceParallel[T any](values []T, workers int) []*T {
+	if values == nil {
+		return nil
+	}
+	result := make([]*T, len(values))
+	parallelChunks(len(values), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			result[i] = &values[i]
+		}
+	})
+	return result
+}
+
+// FromSliceParallel is like FromSlice but splits the work across workers
+// goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) is used.
+// Nil pointers are converted to zero values.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	values := ptr.FromSliceParallel(ptrs, 8)
+func FromSliceParallel[T any](ptrs []*T, workers int) []T {
+	if ptrs == nil {
+		return nil
+	}
+	result := make([]T, len(ptrs))
+	parallelChunks(len(ptrs), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			result[i] = From(ptrs[i])
+		}
+	})
+	return result
+}
+
+// ToMapParallel is like ToMap but allocates the pointed-to values across
+// workers goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) is
+// used. The result map itself is filled by the calling goroutine.
+// Returns nil if the input map is nil.
+//
+// Example:
+//
+//	ptrs := ptr.ToMapParallel(values, 8)
+func ToMapParallel[T any](values map[string]T, workers int) map[string]*T {
+	if values == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(values))
+	vals := make([]T, 0, len(values))
+	for k, v := range values {
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+	ptrs := make([]*T, len(vals))
+	parallelChunks(len(vals), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			v := vals[i]
+			ptrs[i] = &v
+		}
+	})
+	result := make(map[string]*T, len(values))
+	for i, k := range keys {
+		result[k] = ptrs[i]
+	}
+	return result
+}
+
+// FromMapParallel is like FromMap but dereferences the values across
+// workers goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) is
+// used. The result map itself is filled by the calling goroutine.
+// Nil pointers are converted to zero values.
+// Returns nil if the input map is nil.
+//
+// Example:
+//
+//	values := ptr.FromMapParallel(ptrs, 8)
+func FromMapParallel[T any](ptrs map[string]*T, workers int) map[string]T {
+	if ptrs == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(ptrs))
+	src := make([]*T, 0, len(ptrs))
+	for k, p := range ptrs {
+		keys = append(keys, k)
+		src = append(src, p)
+	}
+	vals := make([]T, len(src))
+	parallelChunks(len(src), workers, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			vals[i] = From(src[i])
+		}
+	})
+	result := make(map[string]T, len(ptrs))
+	for i, k := range keys {
+		result[k] = vals[i]
+	}
+	return result
+}
+
+// parallelChunks splits [0, n) into contiguous ranges and calls fn for each
+// range on its own goroutine, returning once all calls have finished.
+func parallelChunks(n, workers int, fn func(lo, hi int)) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+	size := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += size {
+		hi := lo + size
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			fn(lo, hi)
+		}(lo, hi)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,89 @@
+package ptr
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestSliceParallel(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+
+	for _, workers := range []int{0, 1, 3, 8, 5000} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			ptrs := ToSliceParallel(values, workers)
+			if len(ptrs) != len(values) {
+				t.Fatalf("expected %d pointers, got %d", len(values), len(ptrs))
+			}
+			for i, p := range ptrs {
+				if p != &values[i] {
+					t.Fatalf("ptrs[%d] does not point into the input", i)
+				}
+			}
+
+			ptrs[10] = nil
+			back := FromSliceParallel(ptrs, workers)
+			for i, v := range back {
+				want := i
+				if i == 10 {
+					want = 0
+				}
+				if v != want {
+					t.Fatalf("back[%d] = %d, want %d", i, v, want)
+				}
+			}
+		})
+	}
+
+	if ToSliceParallel[int](nil, 4) != nil {
+		t.Error("expected nil for nil input")
+	}
+	if FromSliceParallel[int](nil, 4) != nil {
+		t.Error("expected nil for nil input")
+	}
+	if result := FromSliceParallel([]*int{}, 4); result == nil || len(result) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", result)
+	}
+}
+
+func TestMapParallel(t *testing.T) {
+	values := make(map[string]int, 500)
+	for i := 0; i < 500; i++ {
+		values[strconv.Itoa(i)] = i
+	}
+
+	for _, workers := range []int{0, 1, 4} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			ptrs := ToMapParallel(values, workers)
+			if len(ptrs) != len(values) {
+				t.Fatalf("expected %d entries, got %d", len(values), len(ptrs))
+			}
+			for k, v := range values {
+				if p := ptrs[k]; p == nil || *p != v {
+					t.Fatalf("ptrs[%q] = %v, want pointer to %d", k, p, v)
+				}
+			}
+
+			ptrs["7"] = nil
+			back := FromMapParallel(ptrs, workers)
+			for k, v := range values {
+				want := v
+				if k == "7" {
+					want = 0
+				}
+				if got, ok := back[k]; !ok || got != want {
+					t.Fatalf("back[%q] = %d, %v; want %d, true", k, got, ok, want)
+				}
+			}
+		})
+	}
+
+	if ToMapParallel[int](nil, 4) != nil {
+		t.Error("expected nil for nil input")
+	}
+	if FromMapParallel[int](nil, 4) != nil {
+		t.Error("expected nil for nil input")
+	}
+}
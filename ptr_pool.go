@@ -0,0 +1,40 @@
+package ptr
+
+import "sync"
+
+// Pool recycles pointers to values of type T using a sync.Pool, to cut
+// allocations for values that are boxed on every request. Put resets the
+// value to its zero value, so Get always returns a pointer to a zero T.
+//
+// The zero value is ready to use. A Pool is safe for concurrent use and
+// must not be copied after first use.
+//
+// Example:
+//
+//	var requests ptr.Pool[Request]
+//
+//	req := requests.Get()
+//	defer requests.Put(req)
+type Pool[T any] struct {
+	pool sync.Pool
+}
+
+// Get returns a pointer to a zero value of T, reusing one from the pool
+// if available and allocating a new one otherwise.
+func (p *Pool[T]) Get() *T {
+	if v, ok := p.pool.Get().(*T); ok {
+		return v
+	}
+	return new(T)
+}
+
+// Put resets the value to its zero value and returns the pointer to the
+// pool. The caller must not use v afterwards. Put does nothing if v is nil.
+func (p *Pool[T]) Put(v *T) {
+	if v == nil {
+		return
+	}
+	var zero T
+	*v = zero
+	p.pool.Put(v)
+}
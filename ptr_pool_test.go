@@ -0,0 +1,53 @@
+package ptr
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	type request struct {
+		ID   int
+		Tags []string
+	}
+
+	var pool Pool[request]
+	r := pool.Get()
+	if r == nil || r.ID != 0 || r.Tags != nil {
+		t.Fatalf("expected pointer to zero value, got %+v", r)
+	}
+
+	r.ID = 42
+	r.Tags = []string{"a"}
+	pool.Put(r)
+	if r.ID != 0 || r.Tags != nil {
+		t.Errorf("expected Put to reset the value, got %+v", r)
+	}
+
+	if got := pool.Get(); got.ID != 0 || got.Tags != nil {
+		t.Errorf("expected Get to return a zero value, got %+v", got)
+	}
+
+	pool.Put(nil)
+}
+
+func TestPoolConcurrent(t *testing.T) {
+	var pool Pool[int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p := pool.Get()
+				if *p != 0 {
+					t.Errorf("expected zero value, got %d", *p)
+					return
+				}
+				*p = n
+				pool.Put(p)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
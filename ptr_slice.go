@@ -1,6 +1,443 @@
 package ptr
 
-import "time"
+import (
+	"sort"
+	"time"
+)
+
+// AllocSlice returns n pointers to zero values, all backed by a single []T
+// allocation. Building a large pointer slice this way costs two allocations
+// instead of one per element. Panics if n is negative, like make.
+//
+// Example:
+//
+//	ptrs := ptr.AllocSlice[Row](len(ids))
+//	for i, id := range ids {
+//	    ptrs[i].ID = id
+//	}
+func AllocSlice[T any](n int) []*T {
+	backing := make([]T, n)
+	result := make([]*T, n)
+	for i := range backing {
+		result[i] = &backing[i]
+	}
+	return result
+}
+
+// EqualSlices returns true if both slices have the same length and every pair
+// of elements is equal according to Equal: two nil pointers are equal, and a
+// nil pointer never equals a non-nil one. Like slices.Equal, a nil slice and
+// an empty slice are considered equal.
+//
+// Example:
+//
+//	a := []*int{ptr.To(1), nil}
+//	b := []*int{ptr.To(1), nil}
+//	ptr.EqualSlices(a, b)  // true
+func EqualSlices[T comparable](a, b []*T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ModifySlice applies a transformation function in place through each
+// non-nil pointer in the slice. Nil pointers are skipped.
+// Returns the number of values that were modified.
+//
+// Example:
+//
+//	ptrs := []*int{ptr.To(1), nil, ptr.To(3)}
+//	n := ptr.ModifySlice(ptrs, func(v int) int { return v * 10 })  // n is 2, values are 10, nil, 30
+func ModifySlice[T any](ptrs []*T, fn func(T) T) int {
+	modified := 0
+	for _, p := range ptrs {
+		if Modify(p, fn) {
+			modified++
+		}
+	}
+	return modified
+}
+
+// SortSlice sorts a slice of pointers in place by the values they point to,
+// in ascending order. Nil pointers are placed first, or last if nilLast is true.
+// The sort is stable, so the result is deterministic for equal values.
+//
+// Example:
+//
+//	ps := []*int{ptr.To(3), nil, ptr.To(1)}
+//	ptr.SortSlice(ps, true)  // [1 3 nil]
+func SortSlice[T Ordered](ptrs []*T, nilLast bool) {
+	SortSliceFunc(ptrs, func(a, b T) bool { return a < b }, nilLast)
+}
+
+// SortSliceFunc sorts a slice of pointers in place using less to compare the
+// values they point to. Nil pointers are placed first, or last if nilLast is
+// true, and less is never called with a nil value. The sort is stable.
+//
+// Example:
+//
+//	users := []*User{{Name: "bob"}, nil, {Name: "alice"}}
+//	ptr.SortSliceFunc(users, func(a, b User) bool { return a.Name < b.Name }, false)
+//	// [nil alice bob]
+func SortSliceFunc[T any](ptrs []*T, less func(a, b T) bool, nilLast bool) {
+	sort.SliceStable(ptrs, func(i, j int) bool {
+		a, b := ptrs[i], ptrs[j]
+		if a == nil || b == nil {
+			if nilLast {
+				return a != nil && b == nil
+			}
+			return a == nil && b != nil
+		}
+		return less(*a, *b)
+	})
+}
+
+// MapSlice applies a transformation function to every non-nil pointer in the
+// slice. Nil pointers are preserved as nil at the same index.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	names := []*string{ptr.To("alice"), nil}
+//	lengths := ptr.MapSlice(names, func(s string) int { return len(s) })  // [5 nil]
+func MapSlice[T, R any](ptrs []*T, fn func(T) R) []*R {
+	if ptrs == nil {
+		return nil
+	}
+	result := make([]*R, len(ptrs))
+	for i, p := range ptrs {
+		result[i] = Map(p, fn)
+	}
+	return result
+}
+
+// FlatMapSlice applies a pointer-returning transformation function to every
+// non-nil pointer in the slice. If keepNil is true, the result has the same
+// length as the input, with nil wherever the input was nil or fn returned nil.
+// If keepNil is false, those nil results are dropped.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	parse := func(s string) *int {
+//	    if v, err := strconv.Atoi(s); err == nil {
+//	        return ptr.To(v)
+//	    }
+//	    return nil
+//	}
+//	in := []*string{ptr.To("1"), ptr.To("x"), nil}
+//	ptr.FlatMapSlice(in, parse, true)   // [1 nil nil]
+//	ptr.FlatMapSlice(in, parse, false)  // [1]
+func FlatMapSlice[T, R any](ptrs []*T, fn func(T) *R, keepNil bool) []*R {
+	if ptrs == nil {
+		return nil
+	}
+	result := make([]*R, 0, len(ptrs))
+	for _, p := range ptrs {
+		r := FlatMap(p, fn)
+		if r != nil || keepNil {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// FilterSlice returns a new slice containing only the non-nil pointers whose
+// values satisfy the predicate, in their original order. It is the slice
+// counterpart of Filter. The predicate is not called for nil pointers.
+// Returns nil if the input slice is nil.
+//
+// Example:
+//
+//	ages := []*int{ptr.To(15), nil, ptr.To(21)}
+//	adults := ptr.FilterSlice(ages, func(a int) bool { return a >= 18 })  // [21]
+func FilterSlice[T any](ptrs []*T, predicate func(T) bool) []*T {
+	if ptrs == nil {
+		return nil
+	}
+	result := make([]*T, 0, len(ptrs))
+	for _, p := range ptrs {
+		if Filter(p, predicate) != nil {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// ReduceSlice folds the values of all non-nil pointers in the slice into a
+// single accumulated result, starting from init. Nil pointers are skipped.
+//
+// Example:
+//
+//	prices := []*float64{ptr.To(9.5), nil, ptr.To(0.5)}
+//	total := ptr.ReduceSlice(prices, 0.0, func(sum, p float64) float64 { return sum + p })  // 10
+func ReduceSlice[T, A any](ptrs []*T, init A, fn func(A, T) A) A {
+	acc := init
+	for _, p := range ptrs {
+		if p != nil {
+			acc = fn(acc, *p)
+		}
+	}
+	return acc
+}
+
+// ForEach calls fn with the value of each non-nil pointer in the slice, in order.
+// It is the slice counterpart of Apply. Nil pointers are skipped.
+//
+// Example:
+//
+//	ptr.ForEach(names, func(name string) {
+//	    fmt.Println(name)
+//	})
+func ForEach[T any](ptrs []*T, fn func(T)) {
+	for _, p := range ptrs {
+		if p != nil {
+			fn(*p)
+		}
+	}
+}
+
+// ForEachIndexed calls fn with the index and value of each non-nil pointer in
+// the slice, in order. Nil pointers are skipped.
+//
+// Example:
+//
+//	ptr.ForEachIndexed(names, func(i int, name string) {
+//	    fmt.Printf("%d: %s\n", i, name)
+//	})
+func ForEachIndexed[T any](ptrs []*T, fn func(int, T)) {
+	for i, p := range ptrs {
+		if p != nil {
+			fn(i, *p)
+		}
+	}
+}
+
+// Compact removes nil pointers from the slice and returns the shortened slice.
+// Like slices.Compact, it works in place: the returned slice shares the input's
+// backing array, and the now-unused tail of the input is set to nil so the
+// garbage collector can reclaim it. Order is preserved.
+//
+// Example:
+//
+//	ps := []*int{ptr.To(1), nil, ptr.To(3)}
+//	ps = ptr.Compact(ps)  // [1 3]
+func Compact[T any](ptrs []*T) []*T {
+	n := 0
+	for _, p := range ptrs {
+		if p != nil {
+			ptrs[n] = p
+			n++
+		}
+	}
+	for i := n; i < len(ptrs); i++ {
+		ptrs[i] = nil
+	}
+	return ptrs[:n]
+}
+
+// Partition splits a slice of pointers in a single pass into the non-nil
+// pointers, in their original order, and the indexes of the nil entries.
+// The input slice is not modified.
+//
+// Example:
+//
+//	present, missing := ptr.Partition([]*int{ptr.To(1), nil, ptr.To(3), nil})
+//	// present is [1 3], missing is [1 3]
+func Partition[T any](ptrs []*T) (nonNil []*T, nilIdx []int) {
+	for i, p := range ptrs {
+		if p == nil {
+			nilIdx = append(nilIdx, i)
+		} else {
+			nonNil = append(nonNil, p)
+		}
+	}
+	return nonNil, nilIdx
+}
+
+// CountNonNil returns the number of non-nil pointers in the slice.
+//
+// Example:
+//
+//	ptr.CountNonNil([]*int{ptr.To(1), nil, ptr.To(3)})  // 2
+func CountNonNil[T any](ptrs []*T) int {
+	n := 0
+	for _, p := range ptrs {
+		if p != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// AllNonNil returns true if every pointer in the slice is non-nil.
+// Returns true for an empty or nil slice.
+//
+// Example:
+//
+//	ptr.AllNonNil([]*int{ptr.To(1), ptr.To(2)})  // true
+//	ptr.AllNonNil([]*int{ptr.To(1), nil})        // false
+func AllNonNil[T any](ptrs []*T) bool {
+	return !AnyNil(ptrs)
+}
+
+// AnyNil returns true if at least one pointer in the slice is nil.
+// Returns false for an empty or nil slice.
+//
+// Example:
+//
+//	ptr.AnyNil([]*int{ptr.To(1), nil})  // true
+func AnyNil[T any](ptrs []*T) bool {
+	for _, p := range ptrs {
+		if p == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstNonNil returns the first non-nil pointer in the slice.
+// It is the slice counterpart of Coalesce. Returns nil if all pointers are nil.
+//
+// Example:
+//
+//	ptr.FirstNonNil([]*int{nil, ptr.To(2), ptr.To(3)})  // returns pointer to 2
+func FirstNonNil[T any](ptrs []*T) *T {
+	return Coalesce(ptrs...)
+}
+
+// LastNonNil returns the last non-nil pointer in the slice.
+// Returns nil if all pointers are nil.
+//
+// Example:
+//
+//	ptr.LastNonNil([]*int{ptr.To(1), ptr.To(2), nil})  // returns pointer to 2
+func LastNonNil[T any](ptrs []*T) *T {
+	for i := len(ptrs) - 1; i >= 0; i-- {
+		if ptrs[i] != nil {
+			return ptrs[i]
+		}
+	}
+	return nil
+}
+
+// FindFunc returns the first non-nil pointer whose value satisfies the predicate.
+// Nil pointers are skipped without calling the predicate.
+// Returns nil if no value matches.
+//
+// Example:
+//
+//	ages := []*int{nil, ptr.To(15), ptr.To(21)}
+//	adult := ptr.FindFunc(ages, func(a int) bool { return a >= 18 })  // pointer to 21
+func FindFunc[T any](ptrs []*T, predicate func(T) bool) *T {
+	if i := IndexFunc(ptrs, predicate); i >= 0 {
+		return ptrs[i]
+	}
+	return nil
+}
+
+// IndexFunc returns the index of the first non-nil pointer whose value
+// satisfies the predicate. Nil pointers are skipped without calling the
+// predicate. Returns -1 if no value matches.
+//
+// Example:
+//
+//	ages := []*int{nil, ptr.To(15), ptr.To(21)}
+//	i := ptr.IndexFunc(ages, func(a int) bool { return a >= 18 })  // 2
+func IndexFunc[T any](ptrs []*T, predicate func(T) bool) int {
+	for i, p := range ptrs {
+		if p != nil && predicate(*p) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Sequence collapses a slice of pointers into a pointer to a slice of values,
+// but only if every element is non-nil. Returns nil if any element is nil.
+// This is the all-or-nothing counterpart of FromSlice.
+//
+// Example:
+//
+//	ptr.Sequence([]*int{ptr.To(1), ptr.To(2)})  // pointer to []int{1, 2}
+//	ptr.Sequence([]*int{ptr.To(1), nil})        // nil
+func Sequence[T any](ptrs []*T) *[]T {
+	if AnyNil(ptrs) {
+		return nil
+	}
+	values := FromSlice(ptrs)
+	return &values
+}
+
+// Traverse applies a pointer-returning function to every value in the slice
+// and returns a pointer to the collected results, but only if every call
+// returns non-nil. It stops at the first nil result and returns nil.
+// A nil or empty input yields a pointer to an empty slice.
+//
+// Example:
+//
+//	parse := func(s string) *int {
+//	    if v, err := strconv.Atoi(s); err == nil {
+//	        return ptr.To(v)
+//	    }
+//	    return nil
+//	}
+//	ptr.Traverse([]string{"1", "2"}, parse)  // pointer to []int{1, 2}
+//	ptr.Traverse([]string{"1", "x"}, parse)  // nil
+func Traverse[T, R any](values []T, fn func(T) *R) *[]R {
+	result := make([]R, len(values))
+	for i, v := range values {
+		r := fn(v)
+		if r == nil {
+			return nil
+		}
+		result[i] = *r
+	}
+	return &result
+}
+
+// Sum adds up the values of all non-nil pointers in the slice.
+// Nil pointers are skipped. Returns the sum and the number of non-nil values.
+//
+// Example:
+//
+//	total, n := ptr.Sum([]*int{ptr.To(1), nil, ptr.To(3)})  // 4, 2
+func Sum[T Number](ptrs []*T) (sum T, count int) {
+	for _, p := range ptrs {
+		if p != nil {
+			sum += *p
+			count++
+		}
+	}
+	return sum, count
+}
+
+// Avg returns the arithmetic mean of the values of all non-nil pointers in the
+// slice, along with the number of non-nil values. Nil pointers are skipped.
+// Returns 0 and a count of 0 if there are no non-nil values.
+//
+// Example:
+//
+//	avg, n := ptr.Avg([]*int{ptr.To(1), nil, ptr.To(4)})  // 2.5, 2
+func Avg[T Number](ptrs []*T) (avg float64, count int) {
+	var sum float64
+	for _, p := range ptrs {
+		if p != nil {
+			sum += float64(*p)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, 0
+	}
+	return sum / float64(count), count
+}
 
 // StringSlice converts a slice of strings to a slice of string pointers.
 func StringSlice(vs []string) []*string {
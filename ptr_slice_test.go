@@ -408,3 +408,582 @@ func TestToByteSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestModifySlice(t *testing.T) {
+	t.Run("mixed nil and non-nil", func(t *testing.T) {
+		ptrs := []*int{Int(1), nil, Int(3)}
+		n := ModifySlice(ptrs, func(v int) int { return v * 10 })
+		if n != 2 {
+			t.Errorf("expected 2 modified, got %d", n)
+		}
+		if *ptrs[0] != 10 || *ptrs[2] != 30 {
+			t.Errorf("expected [10 nil 30], got [%d nil %d]", *ptrs[0], *ptrs[2])
+		}
+		if ptrs[1] != nil {
+			t.Error("expected nil entry to stay nil")
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		called := false
+		n := ModifySlice[int](nil, func(v int) int {
+			called = true
+			return v
+		})
+		if n != 0 {
+			t.Errorf("expected 0 modified, got %d", n)
+		}
+		if called {
+			t.Error("function should not be called for nil slice")
+		}
+	})
+
+	t.Run("all nil", func(t *testing.T) {
+		n := ModifySlice([]*string{nil, nil}, func(s string) string { return s + "!" })
+		if n != 0 {
+			t.Errorf("expected 0 modified, got %d", n)
+		}
+	})
+}
+
+func TestSortSlice(t *testing.T) {
+	values := func(ps []*int) []any {
+		out := make([]any, len(ps))
+		for i, p := range ps {
+			if p == nil {
+				out[i] = nil
+			} else {
+				out[i] = *p
+			}
+		}
+		return out
+	}
+
+	t.Run("nil last", func(t *testing.T) {
+		ps := []*int{Int(3), nil, Int(1), nil, Int(2)}
+		SortSlice(ps, true)
+		want := []any{1, 2, 3, nil, nil}
+		if got := values(ps); !equalAny(got, want) {
+			t.Errorf("SortSlice() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("nil first", func(t *testing.T) {
+		ps := []*int{Int(3), nil, Int(1)}
+		SortSlice(ps, false)
+		want := []any{nil, 1, 3}
+		if got := values(ps); !equalAny(got, want) {
+			t.Errorf("SortSlice() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		ps := []*string{String("b"), String("a")}
+		SortSlice(ps, true)
+		if *ps[0] != "a" || *ps[1] != "b" {
+			t.Errorf("SortSlice() = [%s %s], want [a b]", *ps[0], *ps[1])
+		}
+	})
+
+	t.Run("empty and nil slices", func(t *testing.T) {
+		SortSlice[int](nil, true)
+		SortSlice([]*int{}, false)
+	})
+}
+
+func TestSortSliceFunc(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	a := &user{"alice", 30}
+	b := &user{"bob", 30}
+	c := &user{"carol", 20}
+
+	ps := []*user{a, nil, b, c}
+	SortSliceFunc(ps, func(x, y user) bool {
+		if x.Name == "" || y.Name == "" {
+			t.Fatal("less called with zero value")
+		}
+		return x.Age < y.Age
+	}, false)
+
+	want := []*user{nil, c, a, b}
+	for i := range want {
+		if ps[i] != want[i] {
+			t.Fatalf("SortSliceFunc()[%d] = %v, want %v", i, ps[i], want[i])
+		}
+	}
+}
+
+func equalAny(a, b []any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []*int
+		wantSum   int
+		wantCount int
+	}{
+		{"nil slice", nil, 0, 0},
+		{"all nil", []*int{nil, nil}, 0, 0},
+		{"mixed", []*int{Int(1), nil, Int(3)}, 4, 2},
+		{"negative", []*int{Int(-5), Int(2)}, -3, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, count := Sum(tt.input)
+			if sum != tt.wantSum || count != tt.wantCount {
+				t.Errorf("Sum() = %d, %d; want %d, %d", sum, count, tt.wantSum, tt.wantCount)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		sum, count := Sum([]*float64{Float64(1.5), nil, Float64(2.5)})
+		if sum != 4.0 || count != 2 {
+			t.Errorf("Sum() = %f, %d; want 4.0, 2", sum, count)
+		}
+	})
+}
+
+func TestAvg(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []*int
+		wantAvg   float64
+		wantCount int
+	}{
+		{"nil slice", nil, 0, 0},
+		{"all nil", []*int{nil}, 0, 0},
+		{"mixed", []*int{Int(1), nil, Int(4)}, 2.5, 2},
+		{"single", []*int{Int(7)}, 7, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avg, count := Avg(tt.input)
+			if avg != tt.wantAvg || count != tt.wantCount {
+				t.Errorf("Avg() = %f, %d; want %f, %d", avg, count, tt.wantAvg, tt.wantCount)
+			}
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		avg, _ := Avg([]*uint8{Uint8(255), Uint8(255)})
+		if avg != 255 {
+			t.Errorf("Avg() = %f, want 255 without overflow", avg)
+		}
+	})
+}
+
+func TestCompact(t *testing.T) {
+	t.Run("removes nils in place", func(t *testing.T) {
+		a, b := Int(1), Int(3)
+		input := []*int{nil, a, nil, b, nil}
+		result := Compact(input)
+		if len(result) != 2 || result[0] != a || result[1] != b {
+			t.Fatalf("Compact() = %v, want [%p %p]", result, a, b)
+		}
+		if &result[0] != &input[0] {
+			t.Error("expected result to share the input's backing array")
+		}
+		for i := len(result); i < len(input); i++ {
+			if input[i] != nil {
+				t.Errorf("expected tail element %d to be cleared", i)
+			}
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		if result := Compact[int](nil); result != nil {
+			t.Errorf("Compact(nil) = %v, want nil", result)
+		}
+	})
+
+	t.Run("no nils", func(t *testing.T) {
+		input := []*string{String("a"), String("b")}
+		if result := Compact(input); len(result) != 2 {
+			t.Errorf("Compact() length = %d, want 2", len(result))
+		}
+	})
+
+	t.Run("all nil", func(t *testing.T) {
+		if result := Compact([]*int{nil, nil}); len(result) != 0 {
+			t.Errorf("Compact() length = %d, want 0", len(result))
+		}
+	})
+}
+
+func TestPartition(t *testing.T) {
+	a, b := Int(1), Int(3)
+
+	t.Run("mixed", func(t *testing.T) {
+		input := []*int{a, nil, b, nil}
+		nonNil, nilIdx := Partition(input)
+		if len(nonNil) != 2 || nonNil[0] != a || nonNil[1] != b {
+			t.Errorf("nonNil = %v, want [%p %p]", nonNil, a, b)
+		}
+		if len(nilIdx) != 2 || nilIdx[0] != 1 || nilIdx[1] != 3 {
+			t.Errorf("nilIdx = %v, want [1 3]", nilIdx)
+		}
+		if input[1] != nil || input[2] != b {
+			t.Error("expected input to be unmodified")
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		nonNil, nilIdx := Partition[int](nil)
+		if nonNil != nil || nilIdx != nil {
+			t.Errorf("Partition(nil) = %v, %v; want nil, nil", nonNil, nilIdx)
+		}
+	})
+
+	t.Run("no nils", func(t *testing.T) {
+		nonNil, nilIdx := Partition([]*int{a, b})
+		if len(nonNil) != 2 || len(nilIdx) != 0 {
+			t.Errorf("Partition() = %v, %v", nonNil, nilIdx)
+		}
+	})
+}
+
+func TestSlicePredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []*int
+		wantCount int
+		wantAll   bool
+		wantAny   bool
+	}{
+		{"nil slice", nil, 0, true, false},
+		{"empty slice", []*int{}, 0, true, false},
+		{"all non-nil", []*int{Int(1), Int(2)}, 2, true, false},
+		{"mixed", []*int{Int(1), nil, Int(3)}, 2, false, true},
+		{"all nil", []*int{nil, nil}, 0, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountNonNil(tt.input); got != tt.wantCount {
+				t.Errorf("CountNonNil() = %d, want %d", got, tt.wantCount)
+			}
+			if got := AllNonNil(tt.input); got != tt.wantAll {
+				t.Errorf("AllNonNil() = %v, want %v", got, tt.wantAll)
+			}
+			if got := AnyNil(tt.input); got != tt.wantAny {
+				t.Errorf("AnyNil() = %v, want %v", got, tt.wantAny)
+			}
+		})
+	}
+}
+
+func TestFirstLastNonNil(t *testing.T) {
+	a, b := Int(1), Int(2)
+
+	tests := []struct {
+		name      string
+		input     []*int
+		wantFirst *int
+		wantLast  *int
+	}{
+		{"nil slice", nil, nil, nil},
+		{"all nil", []*int{nil, nil}, nil, nil},
+		{"single", []*int{nil, a, nil}, a, a},
+		{"multiple", []*int{nil, a, b, nil}, a, b},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstNonNil(tt.input); got != tt.wantFirst {
+				t.Errorf("FirstNonNil() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := LastNonNil(tt.input); got != tt.wantLast {
+				t.Errorf("LastNonNil() = %v, want %v", got, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestFindFunc(t *testing.T) {
+	adult := func(a int) bool { return a >= 18 }
+	match := Int(21)
+
+	tests := []struct {
+		name      string
+		input     []*int
+		wantPtr   *int
+		wantIndex int
+	}{
+		{"nil slice", nil, nil, -1},
+		{"no match", []*int{Int(10), nil}, nil, -1},
+		{"skips nils", []*int{nil, Int(15), match, Int(30)}, match, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindFunc(tt.input, adult); got != tt.wantPtr {
+				t.Errorf("FindFunc() = %v, want %v", got, tt.wantPtr)
+			}
+			if got := IndexFunc(tt.input, adult); got != tt.wantIndex {
+				t.Errorf("IndexFunc() = %d, want %d", got, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestMapSlice(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	t.Run("preserves nils", func(t *testing.T) {
+		result := MapSlice([]*string{String("alice"), nil, String("bo")}, length)
+		if len(result) != 3 {
+			t.Fatalf("MapSlice() length = %d, want 3", len(result))
+		}
+		if result[0] == nil || *result[0] != 5 {
+			t.Errorf("MapSlice()[0] = %v, want 5", result[0])
+		}
+		if result[1] != nil {
+			t.Errorf("MapSlice()[1] = %v, want nil", result[1])
+		}
+		if result[2] == nil || *result[2] != 2 {
+			t.Errorf("MapSlice()[2] = %v, want 2", result[2])
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		if result := MapSlice(nil, length); result != nil {
+			t.Errorf("MapSlice(nil) = %v, want nil", result)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := MapSlice([]*string{}, length)
+		if result == nil || len(result) != 0 {
+			t.Errorf("MapSlice([]) = %v, want empty slice", result)
+		}
+	})
+}
+
+func TestFilterSlice(t *testing.T) {
+	adult := func(a int) bool { return a >= 18 }
+
+	t.Run("drops nils and non-matching", func(t *testing.T) {
+		a, b := Int(21), Int(30)
+		result := FilterSlice([]*int{Int(15), nil, a, b}, adult)
+		if len(result) != 2 || result[0] != a || result[1] != b {
+			t.Errorf("FilterSlice() = %v, want [%p %p]", result, a, b)
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		if result := FilterSlice(nil, adult); result != nil {
+			t.Errorf("FilterSlice(nil) = %v, want nil", result)
+		}
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		result := FilterSlice([]*int{Int(1), nil}, adult)
+		if result == nil || len(result) != 0 {
+			t.Errorf("FilterSlice() = %v, want empty slice", result)
+		}
+	})
+}
+
+func TestReduceSlice(t *testing.T) {
+	t.Run("skips nils", func(t *testing.T) {
+		total := ReduceSlice([]*int{Int(1), nil, Int(2), Int(3)}, 10, func(acc, v int) int { return acc + v })
+		if total != 16 {
+			t.Errorf("ReduceSlice() = %d, want 16", total)
+		}
+	})
+
+	t.Run("nil slice returns init", func(t *testing.T) {
+		result := ReduceSlice[int](nil, "init", func(acc string, v int) string { return acc + "x" })
+		if result != "init" {
+			t.Errorf("ReduceSlice(nil) = %q, want %q", result, "init")
+		}
+	})
+
+	t.Run("different accumulator type", func(t *testing.T) {
+		counts := ReduceSlice([]*string{String("a"), String("b"), nil, String("a")}, map[string]int{},
+			func(acc map[string]int, s string) map[string]int {
+				acc[s]++
+				return acc
+			})
+		if counts["a"] != 2 || counts["b"] != 1 || len(counts) != 2 {
+			t.Errorf("ReduceSlice() = %v, want map[a:2 b:1]", counts)
+		}
+	})
+}
+
+func TestForEach(t *testing.T) {
+	input := []*string{String("a"), nil, String("c")}
+
+	t.Run("values", func(t *testing.T) {
+		var got []string
+		ForEach(input, func(s string) { got = append(got, s) })
+		if len(got) != 2 || got[0] != "a" || got[1] != "c" {
+			t.Errorf("ForEach() visited %v, want [a c]", got)
+		}
+	})
+
+	t.Run("indexed", func(t *testing.T) {
+		var idx []int
+		ForEachIndexed(input, func(i int, s string) { idx = append(idx, i) })
+		if len(idx) != 2 || idx[0] != 0 || idx[1] != 2 {
+			t.Errorf("ForEachIndexed() visited %v, want [0 2]", idx)
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		ForEach[int](nil, func(int) { t.Error("fn should not be called") })
+		ForEachIndexed[int](nil, func(int, int) { t.Error("fn should not be called") })
+	})
+}
+
+func TestFlatMapSlice(t *testing.T) {
+	positive := func(v int) *int {
+		if v <= 0 {
+			return nil
+		}
+		return Int(v * 10)
+	}
+	input := []*int{Int(1), Int(-1), nil, Int(2)}
+
+	t.Run("keep nil", func(t *testing.T) {
+		result := FlatMapSlice(input, positive, true)
+		if len(result) != 4 {
+			t.Fatalf("FlatMapSlice() length = %d, want 4", len(result))
+		}
+		if *result[0] != 10 || result[1] != nil || result[2] != nil || *result[3] != 20 {
+			t.Errorf("FlatMapSlice() = %v, want [10 nil nil 20]", result)
+		}
+	})
+
+	t.Run("drop nil", func(t *testing.T) {
+		result := FlatMapSlice(input, positive, false)
+		if len(result) != 2 || *result[0] != 10 || *result[1] != 20 {
+			t.Errorf("FlatMapSlice() = %v, want [10 20]", result)
+		}
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		if result := FlatMapSlice(nil, positive, true); result != nil {
+			t.Errorf("FlatMapSlice(nil) = %v, want nil", result)
+		}
+	})
+}
+
+func TestEqualSlices(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []*int
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"nil and empty", nil, []*int{}, true},
+		{"equal values", []*int{Int(1), nil, Int(3)}, []*int{Int(1), nil, Int(3)}, true},
+		{"different lengths", []*int{Int(1)}, []*int{Int(1), Int(2)}, false},
+		{"different values", []*int{Int(1)}, []*int{Int(2)}, false},
+		{"nil vs non-nil element", []*int{nil}, []*int{Int(0)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualSlices(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualSlices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("all present", func(t *testing.T) {
+		result := Sequence([]*int{Int(1), Int(2)})
+		if result == nil {
+			t.Fatal("expected non-nil")
+		}
+		if len(*result) != 2 || (*result)[0] != 1 || (*result)[1] != 2 {
+			t.Errorf("Sequence() = %v, want [1 2]", *result)
+		}
+	})
+
+	t.Run("any nil", func(t *testing.T) {
+		if result := Sequence([]*int{Int(1), nil}); result != nil {
+			t.Errorf("Sequence() = %v, want nil", *result)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := Sequence([]*int{})
+		if result == nil || len(*result) != 0 {
+			t.Errorf("Sequence([]) = %v, want pointer to empty slice", result)
+		}
+	})
+}
+
+func TestTraverse(t *testing.T) {
+	calls := 0
+	positive := func(v int) *int {
+		calls++
+		if v <= 0 {
+			return nil
+		}
+		return Int(v * 10)
+	}
+
+	t.Run("all succeed", func(t *testing.T) {
+		result := Traverse([]int{1, 2}, positive)
+		if result == nil || len(*result) != 2 || (*result)[0] != 10 || (*result)[1] != 20 {
+			t.Errorf("Traverse() = %v, want [10 20]", result)
+		}
+	})
+
+	t.Run("short circuits", func(t *testing.T) {
+		calls = 0
+		if result := Traverse([]int{1, -1, 2}, positive); result != nil {
+			t.Errorf("Traverse() = %v, want nil", *result)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := Traverse(nil, positive)
+		if result == nil || len(*result) != 0 {
+			t.Errorf("Traverse(nil) = %v, want pointer to empty slice", result)
+		}
+	})
+}
+
+func TestAllocSlice(t *testing.T) {
+	ptrs := AllocSlice[int](3)
+	if len(ptrs) != 3 {
+		t.Fatalf("expected 3 pointers, got %d", len(ptrs))
+	}
+	for i, p := range ptrs {
+		if p == nil || *p != 0 {
+			t.Errorf("ptrs[%d] = %v, want pointer to 0", i, p)
+		}
+		*p = i * 10
+	}
+	if *ptrs[0] != 0 || *ptrs[1] != 10 || *ptrs[2] != 20 {
+		t.Errorf("expected distinct values, got %d %d %d", *ptrs[0], *ptrs[1], *ptrs[2])
+	}
+
+	if ptrs := AllocSlice[string](0); len(ptrs) != 0 {
+		t.Errorf("expected empty slice, got %d elements", len(ptrs))
+	}
+}
@@ -0,0 +1,380 @@
+package ptr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Nullable represents a value of type T that may be NULL in a database.
+// It implements sql.Scanner and driver.Valuer, so it can be used directly as a
+// column type in database models. The field is named V rather than Value
+// because Value is the method required by driver.Valuer.
+//
+// Example:
+//
+//	var email ptr.Nullable[string]
+//	err := row.Scan(&email)
+//	fmt.Println(ptr.FromOr(email.Ptr(), "(none)"))
+type Nullable[T any] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NullableFrom converts a pointer to a Nullable.
+// A nil pointer becomes an invalid (NULL) Nullable.
+//
+// Example:
+//
+//	n := ptr.NullableFrom(ptr.String("alice"))  // {V: "alice", Valid: true}
+//	n = ptr.NullableFrom[string](nil)           // {V: "", Valid: false}
+func NullableFrom[T any](p *T) Nullable[T] {
+	if p == nil {
+		return Nullable[T]{}
+	}
+	return Nullable[T]{V: *p, Valid: true}
+}
+
+// Ptr converts the Nullable to a pointer.
+// Returns nil if the Nullable is not valid.
+func (n Nullable[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	v := n.V
+	return &v
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Nullable[T]) Scan(src any) error {
+	if src == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if err := scanInto(&n.V, src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// Column adapts a pointer field to sql.Scanner and driver.Valuer, so models
+// built on raw pointers can be scanned and written without intermediate Null
+// types. NULL maps to a nil pointer and vice versa. Create one with ColumnOf.
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int64
+//	    Email *string
+//	}
+//	var u User
+//	err := row.Scan(&u.ID, ptr.ColumnOf(&u.Email))
+//	_, err = db.Exec(`UPDATE users SET email = ?`, ptr.ColumnOf(&u.Email))
+type Column[T any] struct {
+	pp **T
+}
+
+// ColumnOf returns a Column that reads and writes the pointer stored in *pp.
+func ColumnOf[T any](pp **T) Column[T] {
+	return Column[T]{pp: pp}
+}
+
+// Scan implements the sql.Scanner interface.
+// NULL sets the pointer to nil; any other value is stored in a newly
+// allocated T, so previously shared pointees are never overwritten.
+func (c Column[T]) Scan(src any) error {
+	if c.pp == nil {
+		return fmt.Errorf("ptr: Scan on Column with nil destination")
+	}
+	if src == nil {
+		*c.pp = nil
+		return nil
+	}
+	v := new(T)
+	if err := scanInto(v, src); err != nil {
+		return err
+	}
+	*c.pp = v
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// A nil pointer is written as NULL.
+func (c Column[T]) Value() (driver.Value, error) {
+	if c.pp == nil {
+		return nil, nil
+	}
+	return NullableFrom(*c.pp).Value()
+}
+
+// scanInto stores a non-nil database value into dst. It delegates to
+// sql.Scanner when *T implements it, and otherwise follows the conversions
+// database/sql applies when scanning into a plain destination: text is parsed
+// into numbers and booleans, numbers, booleans, and times are formatted into
+// text, integers 0 and 1 become booleans, integers convert between kinds when
+// the value fits exactly, and floats are rounded to the destination's
+// precision. Byte slices are copied, since the driver may reuse them.
+func scanInto[T any](dst *T, src any) error {
+	if s, ok := any(dst).(sql.Scanner); ok {
+		return s.Scan(src)
+	}
+	if b, ok := src.([]byte); ok {
+		src = append(make([]byte, 0, len(b)), b...)
+	}
+	if v, ok := src.(T); ok {
+		*dst = v
+		return nil
+	}
+
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src)
+
+	// Text values are parsed when the destination is not itself textual.
+	var text string
+	isText := false
+	switch s := src.(type) {
+	case string:
+		text, isText = s, true
+	case []byte:
+		text, isText = string(s), true
+	}
+
+	switch dv.Kind() {
+	case reflect.String:
+		if isText {
+			dv.SetString(text)
+			return nil
+		}
+		if s, ok := formatScalar(sv); ok {
+			dv.SetString(s)
+			return nil
+		}
+	case reflect.Slice:
+		if dv.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		if isText {
+			dv.SetBytes([]byte(text))
+			return nil
+		}
+		if s, ok := formatScalar(sv); ok {
+			dv.SetBytes([]byte(s))
+			return nil
+		}
+	case reflect.Bool:
+		v, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return fmt.Errorf("ptr: converting %v to %s: %w", src, dv.Type(), err)
+		}
+		dv.SetBool(v.(bool))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isText {
+			i, err := strconv.ParseInt(text, 10, dv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("ptr: converting %q to %s: %w", text, dv.Type(), err)
+			}
+			dv.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if isText {
+			u, err := strconv.ParseUint(text, 10, dv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("ptr: converting %q to %s: %w", text, dv.Type(), err)
+			}
+			dv.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		// Like database/sql, numbers are parsed from their text form, so
+		// float64 narrows to float32 with rounding and only values beyond
+		// the destination's range fail.
+		if !isText {
+			text, isText = formatScalar(sv)
+		}
+		if isText {
+			f, err := strconv.ParseFloat(text, dv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("ptr: converting %q to %s: %w", text, dv.Type(), err)
+			}
+			dv.SetFloat(f)
+			return nil
+		}
+	}
+
+	if !isText && sv.Type().ConvertibleTo(dv.Type()) && numericOrSame(sv.Kind(), dv.Kind()) {
+		c := sv.Convert(dv.Type())
+		if isNumericKind(sv.Kind()) && c.Convert(sv.Type()).Interface() != sv.Interface() {
+			return fmt.Errorf("ptr: converting %v to %s: value out of range", src, dv.Type())
+		}
+		dv.Set(c)
+		return nil
+	}
+	return fmt.Errorf("ptr: unsupported scan, storing driver.Value type %T into type %s", src, dv.Type())
+}
+
+// formatScalar formats a boolean, numeric, or time value as text, the way
+// database/sql does when scanning such a value into a string.
+func formatScalar(v reflect.Value) (string, bool) {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339Nano), true
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// numericOrSame reports whether a value of kind src may be converted to kind
+// dst without changing its meaning (e.g. int64 to a rune-typed string is not).
+func numericOrSame(src, dst reflect.Kind) bool {
+	return src == dst || (isNumericKind(src) && isNumericKind(dst))
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// FromNullString converts a sql.NullString to a *string.
+// Returns nil if the value is NULL.
+//
+// Example:
+//
+//	var ns sql.NullString
+//	_ = row.Scan(&ns)
+//	user.Nickname = ptr.FromNullString(ns)
+func FromNullString(n sql.NullString) *string {
+	if !n.Valid {
+		return nil
+	}
+	return &n.String
+}
+
+// ToNullString converts a *string to a sql.NullString.
+// A nil pointer becomes NULL.
+func ToNullString(p *string) sql.NullString {
+	if p == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *p, Valid: true}
+}
+
+// FromNullInt64 converts a sql.NullInt64 to a *int64.
+// Returns nil if the value is NULL.
+func FromNullInt64(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
+
+// ToNullInt64 converts a *int64 to a sql.NullInt64.
+// A nil pointer becomes NULL.
+func ToNullInt64(p *int64) sql.NullInt64 {
+	if p == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *p, Valid: true}
+}
+
+// FromNullInt32 converts a sql.NullInt32 to a *int32.
+// Returns nil if the value is NULL.
+func FromNullInt32(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int32
+}
+
+// ToNullInt32 converts a *int32 to a sql.NullInt32.
+// A nil pointer becomes NULL.
+func ToNullInt32(p *int32) sql.NullInt32 {
+	if p == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *p, Valid: true}
+}
+
+// FromNullFloat64 converts a sql.NullFloat64 to a *float64.
+// Returns nil if the value is NULL.
+func FromNullFloat64(n sql.NullFloat64) *float64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Float64
+}
+
+// ToNullFloat64 converts a *float64 to a sql.NullFloat64.
+// A nil pointer becomes NULL.
+func ToNullFloat64(p *float64) sql.NullFloat64 {
+	if p == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *p, Valid: true}
+}
+
+// FromNullBool converts a sql.NullBool to a *bool.
+// Returns nil if the value is NULL.
+func FromNullBool(n sql.NullBool) *bool {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Bool
+}
+
+// ToNullBool converts a *bool to a sql.NullBool.
+// A nil pointer becomes NULL.
+func ToNullBool(p *bool) sql.NullBool {
+	if p == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *p, Valid: true}
+}
+
+// FromNullTime converts a sql.NullTime to a *time.Time.
+// Returns nil if the value is NULL.
+func FromNullTime(n sql.NullTime) *time.Time {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Time
+}
+
+// ToNullTime converts a *time.Time to a sql.NullTime.
+// A nil pointer becomes NULL.
+func ToNullTime(p *time.Time) sql.NullTime {
+	if p == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *p, Valid: true}
+}
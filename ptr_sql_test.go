@@ -0,0 +1,403 @@
+package ptr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = (*Nullable[string])(nil)
+	_ driver.Valuer = Nullable[string]{}
+	_ sql.Scanner   = Column[string]{}
+	_ driver.Valuer = Column[string]{}
+)
+
+func TestNullableFrom(t *testing.T) {
+	n := NullableFrom(String("alice"))
+	if !n.Valid || n.V != "alice" {
+		t.Errorf("expected {alice true}, got %+v", n)
+	}
+
+	n = NullableFrom[string](nil)
+	if n.Valid || n.V != "" {
+		t.Errorf("expected {\"\" false}, got %+v", n)
+	}
+}
+
+func TestNullablePtr(t *testing.T) {
+	n := Nullable[int]{V: 42, Valid: true}
+	p := n.Ptr()
+	if p == nil || *p != 42 {
+		t.Fatalf("expected pointer to 42, got %v", p)
+	}
+	*p = 100
+	if n.V != 42 {
+		t.Error("expected Ptr to return a copy")
+	}
+
+	if (Nullable[int]{V: 42}).Ptr() != nil {
+		t.Error("expected nil for invalid Nullable")
+	}
+}
+
+func TestNullableScan(t *testing.T) {
+	now := time.Now()
+
+	t.Run("nil resets", func(t *testing.T) {
+		n := Nullable[string]{V: "old", Valid: true}
+		if err := n.Scan(nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n.Valid || n.V != "" {
+			t.Errorf("expected reset Nullable, got %+v", n)
+		}
+	})
+
+	t.Run("string from bytes", func(t *testing.T) {
+		var n Nullable[string]
+		if err := n.Scan([]byte("hello")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !n.Valid || n.V != "hello" {
+			t.Errorf("expected hello, got %+v", n)
+		}
+	})
+
+	t.Run("int from int64", func(t *testing.T) {
+		var n Nullable[int]
+		if err := n.Scan(int64(42)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !n.Valid || n.V != 42 {
+			t.Errorf("expected 42, got %+v", n)
+		}
+	})
+
+	t.Run("int32 from text", func(t *testing.T) {
+		var n Nullable[int32]
+		if err := n.Scan([]byte("-7")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n.V != -7 {
+			t.Errorf("expected -7, got %d", n.V)
+		}
+	})
+
+	t.Run("uint and float from text", func(t *testing.T) {
+		var u Nullable[uint16]
+		if err := u.Scan("65535"); err != nil || u.V != 65535 {
+			t.Errorf("expected 65535, got %d (%v)", u.V, err)
+		}
+		var f Nullable[float64]
+		if err := f.Scan("2.5"); err != nil || f.V != 2.5 {
+			t.Errorf("expected 2.5, got %f (%v)", f.V, err)
+		}
+	})
+
+	t.Run("float32 from float64 and int64", func(t *testing.T) {
+		tests := []struct {
+			src  any
+			want float32
+		}{
+			{0.1, 0.1},
+			{int64(3), 3},
+			{1e38, 1e38},
+		}
+		for _, tt := range tests {
+			var n Nullable[float32]
+			if err := n.Scan(tt.src); err != nil || n.V != tt.want {
+				t.Errorf("Scan(%v): expected %v, got %v (%v)", tt.src, tt.want, n.V, err)
+			}
+			var p *float32
+			if err := ColumnOf(&p).Scan(tt.src); err != nil || p == nil || *p != tt.want {
+				t.Errorf("Column Scan(%v): expected %v, got %v (%v)", tt.src, tt.want, p, err)
+			}
+		}
+		var n Nullable[float32]
+		if err := n.Scan(1e300); err == nil {
+			t.Error("expected out of range error")
+		}
+	})
+
+	t.Run("bool from text and bool", func(t *testing.T) {
+		var n Nullable[bool]
+		if err := n.Scan("true"); err != nil || !n.V {
+			t.Errorf("expected true, got %v (%v)", n.V, err)
+		}
+		if err := n.Scan(false); err != nil || n.V {
+			t.Errorf("expected false, got %v (%v)", n.V, err)
+		}
+		if err := n.Scan(int64(1)); err != nil || !n.V {
+			t.Errorf("expected true from 1, got %v (%v)", n.V, err)
+		}
+	})
+
+	t.Run("text from numbers matches database/sql", func(t *testing.T) {
+		for _, src := range []any{int64(65), -3.25, float32(0.1), true, now} {
+			var ns sql.NullString
+			if err := ns.Scan(src); err != nil {
+				t.Fatalf("sql.NullString.Scan(%v): %v", src, err)
+			}
+			var n Nullable[string]
+			if err := n.Scan(src); err != nil || n.V != ns.String {
+				t.Errorf("Scan(%v): expected %q, got %q (%v)", src, ns.String, n.V, err)
+			}
+			var b Nullable[[]byte]
+			if err := b.Scan(src); err != nil || string(b.V) != ns.String {
+				t.Errorf("Scan(%v) into []byte: expected %q, got %q (%v)", src, ns.String, b.V, err)
+			}
+		}
+	})
+
+	t.Run("bytes are copied", func(t *testing.T) {
+		src := []byte("raw")
+		var n Nullable[[]byte]
+		if err := n.Scan(src); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var a Nullable[any]
+		if err := a.Scan(src); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		copy(src, "xxx")
+		if string(n.V) != "raw" || string(a.V.([]byte)) != "raw" {
+			t.Errorf("expected copies of the driver buffer, got %q and %q", n.V, a.V)
+		}
+	})
+
+	t.Run("bytes from string", func(t *testing.T) {
+		var n Nullable[[]byte]
+		if err := n.Scan("raw"); err != nil || string(n.V) != "raw" {
+			t.Errorf("expected raw, got %q (%v)", n.V, err)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		var n Nullable[time.Time]
+		if err := n.Scan(now); err != nil || !n.V.Equal(now) {
+			t.Errorf("expected %v, got %v (%v)", now, n.V, err)
+		}
+	})
+
+	t.Run("delegates to scanner", func(t *testing.T) {
+		var n Nullable[sql.NullInt64]
+		if err := n.Scan(int64(5)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !n.V.Valid || n.V.Int64 != 5 {
+			t.Errorf("expected scanned NullInt64, got %+v", n.V)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var i8 Nullable[int8]
+		if err := i8.Scan(int64(1000)); err == nil {
+			t.Error("expected out of range error")
+		}
+		if i8.Valid {
+			t.Error("expected Valid false after error")
+		}
+		var i Nullable[int]
+		if err := i.Scan("abc"); err == nil {
+			t.Error("expected parse error")
+		}
+		var ts Nullable[int64]
+		if err := ts.Scan(now); err == nil {
+			t.Error("expected error converting time to int64")
+		}
+		var b Nullable[bool]
+		if err := b.Scan("maybe"); err == nil {
+			t.Error("expected parse error")
+		}
+		if err := b.Scan(int64(2)); err == nil {
+			t.Error("expected error converting 2 to bool")
+		}
+	})
+}
+
+func TestNullableValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{"invalid", Nullable[string]{V: "x"}, nil},
+		{"string", Nullable[string]{V: "x", Valid: true}, "x"},
+		{"int", Nullable[int]{V: 42, Valid: true}, int64(42)},
+		{"float32", Nullable[float32]{V: 1.5, Valid: true}, float64(1.5)},
+		{"bool", Nullable[bool]{V: true, Valid: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullTypeConversions(t *testing.T) {
+	now := time.Now()
+
+	t.Run("string", func(t *testing.T) {
+		if p := FromNullString(sql.NullString{String: "a", Valid: true}); p == nil || *p != "a" {
+			t.Errorf("FromNullString() = %v, want pointer to a", p)
+		}
+		if p := FromNullString(sql.NullString{String: "a"}); p != nil {
+			t.Errorf("FromNullString(NULL) = %v, want nil", p)
+		}
+		if n := ToNullString(String("a")); !n.Valid || n.String != "a" {
+			t.Errorf("ToNullString() = %+v, want {a true}", n)
+		}
+		if n := ToNullString(nil); n.Valid {
+			t.Errorf("ToNullString(nil) = %+v, want NULL", n)
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		if p := FromNullInt64(sql.NullInt64{Int64: 5, Valid: true}); p == nil || *p != 5 {
+			t.Errorf("FromNullInt64() = %v, want pointer to 5", p)
+		}
+		if p := FromNullInt64(sql.NullInt64{}); p != nil {
+			t.Errorf("FromNullInt64(NULL) = %v, want nil", p)
+		}
+		if n := ToNullInt64(Int64(5)); !n.Valid || n.Int64 != 5 {
+			t.Errorf("ToNullInt64() = %+v, want {5 true}", n)
+		}
+		if n := ToNullInt64(nil); n.Valid {
+			t.Errorf("ToNullInt64(nil) = %+v, want NULL", n)
+		}
+	})
+
+	t.Run("int32", func(t *testing.T) {
+		if p := FromNullInt32(sql.NullInt32{Int32: 5, Valid: true}); p == nil || *p != 5 {
+			t.Errorf("FromNullInt32() = %v, want pointer to 5", p)
+		}
+		if p := FromNullInt32(sql.NullInt32{}); p != nil {
+			t.Errorf("FromNullInt32(NULL) = %v, want nil", p)
+		}
+		if n := ToNullInt32(Int32(5)); !n.Valid || n.Int32 != 5 {
+			t.Errorf("ToNullInt32() = %+v, want {5 true}", n)
+		}
+		if n := ToNullInt32(nil); n.Valid {
+			t.Errorf("ToNullInt32(nil) = %+v, want NULL", n)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		if p := FromNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true}); p == nil || *p != 1.5 {
+			t.Errorf("FromNullFloat64() = %v, want pointer to 1.5", p)
+		}
+		if p := FromNullFloat64(sql.NullFloat64{}); p != nil {
+			t.Errorf("FromNullFloat64(NULL) = %v, want nil", p)
+		}
+		if n := ToNullFloat64(Float64(1.5)); !n.Valid || n.Float64 != 1.5 {
+			t.Errorf("ToNullFloat64() = %+v, want {1.5 true}", n)
+		}
+		if n := ToNullFloat64(nil); n.Valid {
+			t.Errorf("ToNullFloat64(nil) = %+v, want NULL", n)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		if p := FromNullBool(sql.NullBool{Bool: false, Valid: true}); p == nil || *p {
+			t.Errorf("FromNullBool() = %v, want pointer to false", p)
+		}
+		if p := FromNullBool(sql.NullBool{}); p != nil {
+			t.Errorf("FromNullBool(NULL) = %v, want nil", p)
+		}
+		if n := ToNullBool(Bool(true)); !n.Valid || !n.Bool {
+			t.Errorf("ToNullBool() = %+v, want {true true}", n)
+		}
+		if n := ToNullBool(nil); n.Valid {
+			t.Errorf("ToNullBool(nil) = %+v, want NULL", n)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		if p := FromNullTime(sql.NullTime{Time: now, Valid: true}); p == nil || !p.Equal(now) {
+			t.Errorf("FromNullTime() = %v, want pointer to %v", p, now)
+		}
+		if p := FromNullTime(sql.NullTime{}); p != nil {
+			t.Errorf("FromNullTime(NULL) = %v, want nil", p)
+		}
+		if n := ToNullTime(Time(now)); !n.Valid || !n.Time.Equal(now) {
+			t.Errorf("ToNullTime() = %+v, want {%v true}", n, now)
+		}
+		if n := ToNullTime(nil); n.Valid {
+			t.Errorf("ToNullTime(nil) = %+v, want NULL", n)
+		}
+	})
+}
+
+func TestColumn(t *testing.T) {
+	t.Run("scan value", func(t *testing.T) {
+		shared := String("old")
+		email := shared
+		if err := ColumnOf(&email).Scan([]byte("a@example.com")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if email == nil || *email != "a@example.com" {
+			t.Errorf("expected a@example.com, got %v", email)
+		}
+		if *shared != "old" {
+			t.Error("expected Scan not to overwrite the previous pointee")
+		}
+	})
+
+	t.Run("scan copies bytes", func(t *testing.T) {
+		src := []byte("raw")
+		var data *[]byte
+		if err := ColumnOf(&data).Scan(src); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		copy(src, "xxx")
+		if data == nil || string(*data) != "raw" {
+			t.Errorf("expected a copy of the driver buffer, got %v", data)
+		}
+	})
+
+	t.Run("scan null", func(t *testing.T) {
+		age := Int(30)
+		if err := ColumnOf(&age).Scan(nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if age != nil {
+			t.Errorf("expected nil, got %v", age)
+		}
+	})
+
+	t.Run("scan error keeps pointer", func(t *testing.T) {
+		age := Int(30)
+		if err := ColumnOf(&age).Scan("abc"); err == nil {
+			t.Error("expected parse error")
+		}
+		if age == nil || *age != 30 {
+			t.Errorf("expected pointer to 30 to be kept, got %v", age)
+		}
+		if err := ColumnOf[int](nil).Scan(int64(1)); err == nil {
+			t.Error("expected error for nil destination")
+		}
+	})
+
+	t.Run("value", func(t *testing.T) {
+		age := Int(30)
+		if v, err := ColumnOf(&age).Value(); err != nil || v != int64(30) {
+			t.Errorf("Value() = %v, %v; want 30, nil", v, err)
+		}
+		age = nil
+		if v, err := ColumnOf(&age).Value(); err != nil || v != nil {
+			t.Errorf("Value() = %v, %v; want nil, nil", v, err)
+		}
+		if v, err := ColumnOf[int](nil).Value(); err != nil || v != nil {
+			t.Errorf("Value() = %v, %v; want nil, nil", v, err)
+		}
+	})
+}
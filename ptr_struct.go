@@ -0,0 +1,867 @@
+package ptr
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Defaults fills the nil pointer fields of target with deep copies of the
+// corresponding fields from defaults, so target never shares memory with
+// defaults. Fields of target that are already set are left untouched. Nested
+// structs, and pointers to structs that are set on both sides, are filled
+// recursively, and cycles are visited once. Unexported fields are ignored.
+//
+// Target must be a non-nil pointer to a struct, and defaults must be a struct
+// (or a pointer to a struct) of the same type. A nil defaults pointer is a no-op.
+//
+// Example:
+//
+//	type Config struct {
+//	    Host *string
+//	    Port *int
+//	}
+//	cfg := Config{Host: ptr.String("example.com")}
+//	err := ptr.Defaults(&cfg, Config{Host: ptr.String("localhost"), Port: ptr.Int(8080)})
+//	// cfg.Host is "example.com", cfg.Port is 8080
+func Defaults(target, defaults any) error {
+	dst, src, err := structPair("Defaults", target, defaults)
+	if err != nil || !src.IsValid() {
+		return err
+	}
+	fillDefaults(dst, src, map[seenKey]reflect.Value{}, map[[2]seenKey]bool{})
+	return nil
+}
+
+// MergePatch returns an RFC 7386 JSON merge patch that transforms the JSON
+// encoding of oldValue into that of newValue. Pointer fields that were set
+// and are now nil become null, fields whose value changed are included, and
+// unchanged fields are omitted. Nested structs, and pointers to structs set
+// on both sides, produce nested patches, and so do maps set on both sides:
+// removed keys become null and changed values are patched recursively. Keys
+// follow the json struct tags, embedded structs are flattened, and fields
+// tagged "-" or unexported are ignored. A field tagged omitempty that becomes
+// empty is omitted from the encoding of newValue, so it becomes null too.
+//
+// Both arguments must be structs (or pointers to structs) of the same type.
+// A nil pointer is treated as the zero value. If nothing changed, the result
+// is the empty patch {}.
+//
+// Example:
+//
+//	type User struct {
+//	    Name  *string `json:"name"`
+//	    Email *string `json:"email"`
+//	    Age   *int    `json:"age"`
+//	}
+//	before := User{Name: ptr.String("Alice"), Email: ptr.String("a@example.com")}
+//	after := User{Name: ptr.String("Alicia"), Age: ptr.Int(30)}
+//	patch, err := ptr.MergePatch(before, after)
+//	// {"name":"Alicia","email":null,"age":30}
+func MergePatch(oldValue, newValue any) ([]byte, error) {
+	o, n, err := structValues("MergePatch", oldValue, newValue)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := mergePatch(o, n)
+	if err != nil {
+		return nil, err
+	}
+	if patch == nil {
+		return []byte("{}"), nil
+	}
+	return patch, nil
+}
+
+// mergePatch returns the merge patch object for two struct values,
+// or nil if they do not differ.
+func mergePatch(o, n reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeMergePatch(&buf, o, n); err != nil {
+		return nil, err
+	}
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+	return append(append([]byte{'{'}, buf.Bytes()...), '}'), nil
+}
+
+// writeMergePatch appends the comma-separated members of the merge patch for
+// two struct values to buf. Embedded structs are flattened as in encoding/json.
+func writeMergePatch(buf *bytes.Buffer, o, n reflect.Value) error {
+	t := n.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, ok := jsonFieldName(f)
+		if !ok {
+			continue
+		}
+		if isFlattened(f) {
+			if err := writeMergePatch(buf, indirectStruct(o.Field(i)), indirectStruct(n.Field(i))); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		of, nf := o.Field(i), n.Field(i)
+
+		var value []byte
+		switch {
+		case !hasJSONOption(f, "omitempty") || !isEmptyValue(nf):
+			var err error
+			if value, err = mergePatchValue(of, nf); err != nil {
+				return fmt.Errorf("ptr: MergePatch field %s: %w", f.Name, err)
+			}
+		case !isEmptyValue(of):
+			value = []byte("null")
+		}
+		if value == nil {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	return nil
+}
+
+// mergePatchValue returns the patch value for a single field or map entry,
+// or nil if it is unchanged.
+func mergePatchValue(of, nf reflect.Value) ([]byte, error) {
+	switch {
+	case nf.Kind() == reflect.Interface && !nf.IsNil() && !of.IsNil() && nf.Elem().Type() == of.Elem().Type():
+		return mergePatchValue(of.Elem(), nf.Elem())
+	case nf.Kind() == reflect.Map && !nf.IsNil() && !of.IsNil() && isObjectMap(nf.Type()):
+		return mergeMapPatch(of, nf)
+	case nf.Kind() == reflect.Pointer:
+		switch {
+		case nf.IsNil() && of.IsNil():
+			return nil, nil
+		case nf.IsNil():
+			return []byte("null"), nil
+		case of.IsNil():
+			return json.Marshal(nf.Interface())
+		case isPlainStruct(nf.Type().Elem()):
+			return mergePatch(of.Elem(), nf.Elem())
+		case reflect.DeepEqual(of.Elem().Interface(), nf.Elem().Interface()):
+			return nil, nil
+		}
+	case isPlainStruct(nf.Type()):
+		return mergePatch(of, nf)
+	case reflect.DeepEqual(of.Interface(), nf.Interface()):
+		return nil, nil
+	}
+	return json.Marshal(nf.Interface())
+}
+
+// mergeMapPatch returns the merge patch object for two non-nil maps encoded
+// as JSON objects, or nil if they do not differ. Removed keys become null,
+// added keys are included, and changed values are patched recursively.
+func mergeMapPatch(o, n reflect.Value) ([]byte, error) {
+	members := map[string][]byte{}
+	for _, k := range o.MapKeys() {
+		if n.MapIndex(k).IsValid() {
+			continue
+		}
+		name, err := mapKeyName(k)
+		if err != nil {
+			return nil, err
+		}
+		members[name] = []byte("null")
+	}
+	for _, k := range n.MapKeys() {
+		name, err := mapKeyName(k)
+		if err != nil {
+			return nil, err
+		}
+		var value []byte
+		if ov := o.MapIndex(k); ov.IsValid() {
+			value, err = mergePatchValue(ov, n.MapIndex(k))
+		} else {
+			value, err = json.Marshal(n.MapIndex(k).Interface())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", name, err)
+		}
+		if value != nil {
+			members[name] = value
+		}
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(members[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// isObjectMap reports whether map type t is encoded by encoding/json as an
+// object whose keys can be named with mapKeyName.
+func isObjectMap(t reflect.Type) bool {
+	if t.Implements(jsonMarshalerType) {
+		return false
+	}
+	k := t.Key()
+	switch k.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return k.Implements(textMarshalerType)
+}
+
+// mapKeyName returns the JSON object key for a map key, following
+// encoding/json: string keys are used directly, then TextMarshaler, then
+// integers in decimal.
+func mapKeyName(k reflect.Value) (string, error) {
+	if k.Kind() == reflect.String {
+		return k.String(), nil
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if k.Kind() == reflect.Pointer && k.IsNil() {
+			return "", nil
+		}
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported map key type %s", k.Type())
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// isPlainStruct reports whether t is a struct encoded field by field,
+// rather than through its own MarshalJSON or MarshalText method.
+func isPlainStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	pt := reflect.PointerTo(t)
+	return !pt.Implements(jsonMarshalerType) && !pt.Implements(textMarshalerType)
+}
+
+// ApplyPatch assigns every non-nil pointer field of patch to the field of
+// dst with the same JSON name (the json tag name, or else the field name).
+// A *T patch field may target either a T or a *T field; pointer targets
+// receive a copy so dst never aliases patch. Nil patch fields, and fields
+// that are not pointers, are left alone. Embedded structs, and embedded
+// pointers to structs, are flattened on both sides; nil embedded pointers are
+// skipped in patch and allocated in dst when one of their fields is set.
+//
+// Dst must be a non-nil pointer to a struct, and patch a struct or a pointer
+// to a struct, usually of a different "patch" type. A nil patch pointer is a
+// no-op. If a set patch field has no matching dst field or its type does not
+// fit, ApplyPatch returns an error and leaves dst unchanged.
+//
+// Example:
+//
+//	type User struct {
+//	    Name  string  `json:"name"`
+//	    Email *string `json:"email"`
+//	}
+//	type UserPatch struct {
+//	    Name  *string `json:"name"`
+//	    Email *string `json:"email"`
+//	}
+//	user := User{Name: "Alice"}
+//	err := ptr.ApplyPatch(&user, UserPatch{Email: ptr.String("a@example.com")})
+//	// user.Name is still "Alice", user.Email is "a@example.com"
+func ApplyPatch(dst, patch any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ptr: ApplyPatch target must be a non-nil pointer to a struct, got %T", dst)
+	}
+	d = d.Elem()
+
+	p := reflect.ValueOf(patch)
+	if p.Kind() == reflect.Pointer && p.Type().Elem().Kind() == reflect.Struct {
+		if p.IsNil() {
+			return nil
+		}
+		p = p.Elem()
+	}
+	if p.Kind() != reflect.Struct {
+		return fmt.Errorf("ptr: ApplyPatch patch must be a struct or a pointer to a struct, got %T", patch)
+	}
+
+	targets := make(map[string][]int)
+	for _, f := range jsonFields(d.Type()) {
+		targets[f.name] = f.index
+	}
+
+	// Validate every assignment before modifying dst.
+	type assignment struct {
+		index []int
+		src   reflect.Value
+	}
+	var assignments []assignment
+	for _, f := range jsonFields(p.Type()) {
+		pf := fieldByIndex(p, f.index)
+		if pf.Kind() != reflect.Pointer || pf.IsNil() {
+			continue
+		}
+		index, ok := targets[f.name]
+		if !ok {
+			return fmt.Errorf("ptr: ApplyPatch %T has no field matching %q", dst, f.name)
+		}
+		dt := d.Type().FieldByIndex(index).Type
+		switch dt {
+		case pf.Type():
+			assignments = append(assignments, assignment{index, copyPointer(pf)})
+		case pf.Type().Elem():
+			assignments = append(assignments, assignment{index, pf.Elem()})
+		default:
+			return fmt.Errorf("ptr: ApplyPatch field %q: cannot assign %s to %s", f.name, pf.Type(), dt)
+		}
+	}
+	for _, a := range assignments {
+		settableField(d, a.index).Set(a.src)
+	}
+	return nil
+}
+
+// Overlay merges two values of the same struct type field by field: each
+// pointer field is taken from override if it is non-nil and from base
+// otherwise. Nested structs, and pointers to structs set on both sides, are
+// merged recursively into new values. All other fields, including
+// unexported ones, are taken from base. It is Coalesce generalized to whole
+// structs.
+//
+// The inputs are not modified, but the result shares pointer fields with
+// them; use DeepCopy on the result if it must be independent.
+//
+// Example:
+//
+//	type Config struct {
+//	    Host *string
+//	    Port *int
+//	}
+//	base := Config{Host: ptr.String("localhost"), Port: ptr.Int(8080)}
+//	cfg := ptr.Overlay(base, Config{Port: ptr.Int(9090)})
+//	// cfg.Host is "localhost", cfg.Port is 9090
+func Overlay[T any](base, override T) T {
+	var result T
+	b, o := reflect.ValueOf(&base).Elem(), reflect.ValueOf(&override).Elem()
+	reflect.ValueOf(&result).Elem().Set(overlayValue(b, o, "", nil))
+	return result
+}
+
+// Provenance records which layer of an OverlayN call supplied each non-nil
+// pointer field of the result. Keys are dotted Go field paths such as
+// "Server.Port"; values are indexes into the layers passed to OverlayN.
+type Provenance map[string]int
+
+// OverlayN merges an ordered list of values with Overlay, later layers taking
+// precedence over earlier ones, and reports which layer supplied each field.
+// It returns the zero value and an empty Provenance when no layers are given.
+//
+// A pointer-to-struct field merged from several layers is attributed to the
+// last layer that set it; its own fields are recorded individually.
+//
+// Example:
+//
+//	cfg, prov := ptr.OverlayN(defaults, fileCfg, envCfg, flagCfg)
+//	if layer, ok := prov["Server.Port"]; ok {
+//	    fmt.Printf("port %d set by layer %d\n", *cfg.Server.Port, layer)
+//	}
+func OverlayN[T any](layers ...T) (T, Provenance) {
+	var result T
+	prov := make(Provenance)
+	r := reflect.ValueOf(&result).Elem()
+	for i := range layers {
+		layer := i
+		record := func(path string) { prov[path] = layer }
+		r.Set(overlayValue(r, reflect.ValueOf(&layers[i]).Elem(), "", record))
+	}
+	return result, prov
+}
+
+// overlayValue returns the overlay of two values of the same type. If record
+// is not nil, it is called with the field path of every non-nil pointer in
+// override.
+func overlayValue(base, override reflect.Value, path string, record func(string)) reflect.Value {
+	switch base.Kind() {
+	case reflect.Pointer:
+		switch {
+		case override.IsNil():
+			return base
+		case base.IsNil() || base.Elem().Kind() != reflect.Struct:
+			if record != nil {
+				recordPointers(override, path, record)
+			}
+			return override
+		}
+		if record != nil && path != "" {
+			record(path)
+		}
+		merged := reflect.New(base.Type().Elem())
+		merged.Elem().Set(overlayValue(base.Elem(), override.Elem(), path, record))
+		return merged
+	case reflect.Struct:
+		merged := reflect.New(base.Type()).Elem()
+		merged.Set(base)
+		for i := 0; i < base.NumField(); i++ {
+			f := base.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			merged.Field(i).Set(overlayValue(base.Field(i), override.Field(i), fieldPath(path, f.Name), record))
+		}
+		return merged
+	}
+	return base
+}
+
+// recordPointers calls record for v, a non-nil pointer, and for every
+// non-nil pointer reachable from it through exported struct fields.
+func recordPointers(v reflect.Value, path string, record func(string)) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		if path != "" {
+			record(path)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Type().Field(i); f.IsExported() {
+			recordPointers(v.Field(i), fieldPath(path, f.Name), record)
+		}
+	}
+}
+
+// fieldPath appends a field name to a dotted path.
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// NilZeroFields sets every pointer field of the struct v points to whose
+// pointee is the zero value to nil, so that `json:",omitempty"` omits it.
+// Nested structs, and non-nil pointers to structs that are not zero, are
+// normalized recursively; note that this modifies the structs those pointers
+// point to. Unexported fields are ignored.
+//
+// NilZeroFields panics if v is not a non-nil pointer to a struct.
+//
+// Example:
+//
+//	u := User{Name: ptr.String("Alice"), Nickname: ptr.String(""), Age: ptr.Int(0)}
+//	ptr.NilZeroFields(&u)
+//	// u.Name is "Alice"; u.Nickname and u.Age are nil
+func NilZeroFields(v any) {
+	nilZeroFields(structTarget("NilZeroFields", v), map[seenKey]bool{})
+}
+
+// nilZeroFields implements NilZeroFields. The seen map tracks struct pointers
+// that have already been visited so that cycles terminate; it is keyed by type
+// as well as address because a struct and its first field share an address.
+func nilZeroFields(v reflect.Value, seen map[seenKey]bool) {
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Pointer:
+			switch {
+			case f.IsNil():
+			case f.Elem().IsZero():
+				f.Set(reflect.Zero(f.Type()))
+			case f.Elem().Kind() == reflect.Struct && !seen[seenKey{f.Type(), f.Pointer()}]:
+				seen[seenKey{f.Type(), f.Pointer()}] = true
+				nilZeroFields(f.Elem(), seen)
+			}
+		case reflect.Struct:
+			nilZeroFields(f, seen)
+		}
+	}
+}
+
+// ZeroNilFields is the inverse of NilZeroFields: it sets every nil pointer
+// field of the struct v points to to a pointer to a new zero value, so the
+// fields can be dereferenced without nil checks. Nested structs and the
+// structs behind pointer fields, including newly allocated ones, are filled
+// recursively. A nil pointer to a struct type that encloses it, such as the
+// Next field of a linked list node, is left nil so that recursive types
+// terminate. Unexported fields are ignored.
+//
+// ZeroNilFields panics if v is not a non-nil pointer to a struct.
+//
+// Example:
+//
+//	var u User
+//	ptr.ZeroNilFields(&u)
+//	fmt.Println(*u.Name, *u.Age)  // "" 0
+func ZeroNilFields(v any) {
+	zeroNilFields(structTarget("ZeroNilFields", v), map[seenKey]bool{}, map[reflect.Type]bool{})
+}
+
+// zeroNilFields implements ZeroNilFields. The seen map tracks struct pointers
+// that have already been visited, by type and address, so that cycles
+// terminate, and enclosing holds the struct types currently being filled.
+func zeroNilFields(v reflect.Value, seen map[seenKey]bool, enclosing map[reflect.Type]bool) {
+	enclosing[v.Type()] = true
+	defer delete(enclosing, v.Type())
+
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Pointer:
+			elem := f.Type().Elem()
+			if f.IsNil() {
+				if enclosing[elem] {
+					continue
+				}
+				f.Set(reflect.New(elem))
+			}
+			if elem.Kind() == reflect.Struct && !seen[seenKey{f.Type(), f.Pointer()}] {
+				seen[seenKey{f.Type(), f.Pointer()}] = true
+				zeroNilFields(f.Elem(), seen, enclosing)
+			}
+		case reflect.Struct:
+			zeroNilFields(f, seen, enclosing)
+		}
+	}
+}
+
+// NilFields returns the dotted paths of all nil pointer fields of v, which
+// must be a struct or a pointer to one, in declaration order. Path segments
+// follow the json struct tags, embedded structs are flattened, and fields
+// tagged "-" or unexported are ignored. Nested structs, and non-nil pointers
+// to structs, are inspected recursively. A nil v, or a nil embedded pointer,
+// is treated as the zero value of its struct type.
+//
+// NilFields panics if v is not a struct or a pointer to a struct.
+//
+// Example:
+//
+//	type Settings struct {
+//	    Theme  *string `json:"theme"`
+//	    Notify struct {
+//	        Email *bool `json:"email"`
+//	    } `json:"notify"`
+//	}
+//	unset := ptr.NilFields(Settings{})
+//	// []string{"theme", "notify.email"}
+func NilFields(v any) []string {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || indirectType(rv.Type()).Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ptr: NilFields argument must be a struct or a pointer to a struct, got %T", v))
+	}
+	var paths []string
+	collectNilFields(indirectStruct(rv), "", &paths, map[seenKey]bool{})
+	return paths
+}
+
+// collectNilFields appends the paths of the nil pointer fields of struct v to
+// paths. The seen map tracks struct pointers that have already been visited
+// so that cycles terminate; it is keyed by type as well as address because a
+// struct and its first field share an address.
+func collectNilFields(v reflect.Value, prefix string, paths *[]string, seen map[seenKey]bool) {
+	for _, jf := range jsonFields(v.Type()) {
+		f := fieldByIndex(v, jf.index)
+		path := fieldPath(prefix, jf.name)
+		switch f.Kind() {
+		case reflect.Pointer:
+			switch {
+			case f.IsNil():
+				*paths = append(*paths, path)
+			case isPlainStruct(f.Type().Elem()) && !seen[seenKey{f.Type(), f.Pointer()}]:
+				seen[seenKey{f.Type(), f.Pointer()}] = true
+				collectNilFields(f.Elem(), path, paths, seen)
+			}
+		case reflect.Struct:
+			if isPlainStruct(f.Type()) {
+				collectNilFields(f, path, paths, seen)
+			}
+		}
+	}
+}
+
+// structTarget validates the argument of the in-place struct walkers and
+// returns the addressable struct it points to. It panics with a message
+// naming fn if v is not a non-nil pointer to a struct.
+func structTarget(fn string, v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ptr: %s argument must be a non-nil pointer to a struct, got %T", fn, v))
+	}
+	return rv.Elem()
+}
+
+// jsonField is an exported struct field together with its JSON name and
+// its index path for reflect.Value.FieldByIndex.
+type jsonField struct {
+	name  string
+	index []int
+}
+
+// jsonFields lists the exported fields of struct type t in declaration
+// order, flattening embedded structs as described for isFlattened and
+// skipping fields tagged "-". Use fieldByIndex to read the fields and
+// settableField to set them.
+func jsonFields(t reflect.Type) []jsonField {
+	return appendJSONFields(nil, t, map[reflect.Type]bool{t: true})
+}
+
+// appendJSONFields implements jsonFields. The enclosing map holds the struct
+// types being flattened, so that a type embedding a pointer to itself
+// terminates.
+func appendJSONFields(fields []jsonField, t reflect.Type, enclosing map[reflect.Type]bool) []jsonField {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, ok := jsonFieldName(f)
+		if !ok {
+			continue
+		}
+		if isFlattened(f) {
+			et := indirectType(f.Type)
+			if enclosing[et] {
+				continue
+			}
+			enclosing[et] = true
+			for _, sub := range appendJSONFields(nil, et, enclosing) {
+				sub.index = append([]int{i}, sub.index...)
+				fields = append(fields, sub)
+			}
+			delete(enclosing, et)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		fields = append(fields, jsonField{name: name, index: []int{i}})
+	}
+	return fields
+}
+
+// fieldByIndex returns the field of struct v at an index path from
+// jsonFields for reading. A nil embedded pointer on the path is read as the
+// zero value of its struct, as in MergePatch.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			v = indirectStruct(v)
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// settableField returns the field of the addressable struct v at an index
+// path from jsonFields, allocating nil embedded pointers on the path.
+func settableField(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// isFlattened reports whether the fields of embedded field f are promoted
+// into the enclosing JSON object, as in encoding/json: f must embed a plain
+// struct or a pointer to one, have no explicit json name, and, if it is a
+// pointer, point to an exported type.
+func isFlattened(f reflect.StructField) bool {
+	return f.Anonymous && !hasJSONName(f) && isPlainStruct(indirectType(f.Type)) &&
+		(f.IsExported() || f.Type.Kind() == reflect.Struct)
+}
+
+// jsonFieldName returns the JSON object key of a struct field following
+// encoding/json: the json tag name if present, otherwise the field name.
+// It reports false for fields tagged "-".
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
+
+// hasJSONName reports whether the field's json tag sets an explicit name.
+func hasJSONName(f reflect.StructField) bool {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name != "" && name != "-"
+}
+
+// hasJSONOption reports whether the field's json tag includes option, such as
+// "omitempty".
+func hasJSONOption(f reflect.StructField, option string) bool {
+	_, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyValue reports whether v is empty in the sense of the omitempty
+// option of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+// indirectType returns the element type of t if it is a pointer type.
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
+
+// indirectStruct dereferences a pointer to a struct, returning the zero
+// struct for a nil pointer.
+func indirectStruct(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Pointer {
+		return v
+	}
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Elem()
+}
+
+// structValues validates two arguments that must be structs, or pointers to
+// structs, of the same type and returns their struct values. A nil pointer
+// yields the zero value of the struct.
+func structValues(fn string, a, b any) (reflect.Value, reflect.Value, error) {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() || av.Type() != bv.Type() || indirectType(av.Type()).Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("ptr: %s arguments must be structs of the same type, got %T and %T", fn, a, b)
+	}
+	return indirectStruct(av), indirectStruct(bv), nil
+}
+
+// structPair validates the arguments of the reflection-based struct helpers.
+// It returns the addressable struct behind target and the struct value of src.
+// The returned src is the zero reflect.Value if src is a nil pointer.
+func structPair(fn string, target, src any) (reflect.Value, reflect.Value, error) {
+	dst := reflect.ValueOf(target)
+	if dst.Kind() != reflect.Pointer || dst.IsNil() || dst.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("ptr: %s target must be a non-nil pointer to a struct, got %T", fn, target)
+	}
+	dst = dst.Elem()
+
+	s := reflect.ValueOf(src)
+	if s.Kind() == reflect.Pointer {
+		if s.Type().Elem() != dst.Type() {
+			return reflect.Value{}, reflect.Value{}, fmt.Errorf("ptr: %s type mismatch: %T and %T", fn, target, src)
+		}
+		if s.IsNil() {
+			return dst, reflect.Value{}, nil
+		}
+		s = s.Elem()
+	}
+	if !s.IsValid() || s.Type() != dst.Type() {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("ptr: %s type mismatch: %T and %T", fn, target, src)
+	}
+	return dst, s, nil
+}
+
+// fillDefaults implements Defaults. The seen map is shared with
+// deepCopyValue so that pointers shared within defaults stay shared in the
+// copies, and visited holds the pairs of struct pointers already filled so
+// that cycles terminate.
+func fillDefaults(dst, src reflect.Value, seen map[seenKey]reflect.Value, visited map[[2]seenKey]bool) {
+	for i := 0; i < dst.NumField(); i++ {
+		if !dst.Type().Field(i).IsExported() {
+			continue
+		}
+		df, sf := dst.Field(i), src.Field(i)
+		switch df.Kind() {
+		case reflect.Pointer:
+			if sf.IsNil() {
+				continue
+			}
+			if df.IsNil() {
+				df.Set(deepCopyValue(sf, seen))
+			} else if df.Elem().Kind() == reflect.Struct {
+				pair := [2]seenKey{{df.Type(), df.Pointer()}, {sf.Type(), sf.Pointer()}}
+				if !visited[pair] {
+					visited[pair] = true
+					fillDefaults(df.Elem(), sf.Elem(), seen, visited)
+				}
+			}
+		case reflect.Struct:
+			fillDefaults(df, sf, seen, visited)
+		}
+	}
+}
+
+// copyPointer returns a new pointer holding a shallow copy of the value p points to.
+func copyPointer(p reflect.Value) reflect.Value {
+	c := reflect.New(p.Type().Elem())
+	c.Elem().Set(p.Elem())
+	return c
+}
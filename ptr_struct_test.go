@@ -0,0 +1,744 @@
+package ptr
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testServer struct {
+	Host *string
+	Port *int
+}
+
+type testConfig struct {
+	Name    *string
+	Debug   *bool
+	Retries int
+	Server  testServer
+	Backup  *testServer
+	secret  *string
+}
+
+func TestDefaults(t *testing.T) {
+	t.Run("fills nil fields only", func(t *testing.T) {
+		cfg := testConfig{Name: String("custom")}
+		defaults := testConfig{Name: String("default"), Debug: Bool(true), Retries: 5}
+		if err := Defaults(&cfg, defaults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *cfg.Name != "custom" {
+			t.Errorf("expected Name 'custom', got %q", *cfg.Name)
+		}
+		if cfg.Debug == nil || !*cfg.Debug {
+			t.Error("expected Debug to be filled with true")
+		}
+		if cfg.Retries != 0 {
+			t.Errorf("expected non-pointer Retries to be untouched, got %d", cfg.Retries)
+		}
+	})
+
+	t.Run("copies instead of aliasing", func(t *testing.T) {
+		var cfg testConfig
+		defaults := testConfig{Debug: Bool(true)}
+		if err := Defaults(&cfg, &defaults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Debug == defaults.Debug {
+			t.Error("expected a copy of the default pointer")
+		}
+	})
+
+	t.Run("recurses into nested structs", func(t *testing.T) {
+		cfg := testConfig{
+			Server: testServer{Host: String("example.com")},
+			Backup: &testServer{Port: Int(9090)},
+		}
+		defaults := testConfig{
+			Server: testServer{Host: String("localhost"), Port: Int(8080)},
+			Backup: &testServer{Host: String("backup"), Port: Int(1)},
+		}
+		if err := Defaults(&cfg, defaults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *cfg.Server.Host != "example.com" || *cfg.Server.Port != 8080 {
+			t.Errorf("unexpected Server: %s:%d", *cfg.Server.Host, *cfg.Server.Port)
+		}
+		if *cfg.Backup.Host != "backup" || *cfg.Backup.Port != 9090 {
+			t.Errorf("unexpected Backup: %s:%d", *cfg.Backup.Host, *cfg.Backup.Port)
+		}
+	})
+
+	t.Run("fills nil struct pointer", func(t *testing.T) {
+		var cfg testConfig
+		defaults := testConfig{Backup: &testServer{Host: String("backup")}}
+		if err := Defaults(&cfg, defaults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Backup == nil || *cfg.Backup.Host != "backup" {
+			t.Error("expected Backup to be filled")
+		}
+	})
+
+	t.Run("deep copies nil struct pointer", func(t *testing.T) {
+		var cfg testConfig
+		defaults := testConfig{Backup: &testServer{Host: String("backup")}}
+		if err := Defaults(&cfg, defaults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		*defaults.Backup.Host = "changed"
+		if cfg.Backup.Host == defaults.Backup.Host || *cfg.Backup.Host != "backup" {
+			t.Errorf("expected Backup.Host to be copied, got %q", *cfg.Backup.Host)
+		}
+	})
+
+	t.Run("ignores unexported fields", func(t *testing.T) {
+		var cfg testConfig
+		if err := Defaults(&cfg, testConfig{secret: String("s")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.secret != nil {
+			t.Error("expected unexported field to be ignored")
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		type node struct {
+			Value *int
+			Next  *node
+		}
+		n := &node{}
+		n.Next = n
+		d := &node{Value: Int(7)}
+		d.Next = d
+		if err := Defaults(n, d); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n.Value == nil || *n.Value != 7 || n.Next != n {
+			t.Errorf("Defaults() = %+v, want Value 7 and self-loop kept", n)
+		}
+	})
+
+	t.Run("nil defaults pointer", func(t *testing.T) {
+		var cfg testConfig
+		if err := Defaults(&cfg, (*testConfig)(nil)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		var cfg testConfig
+		tests := []struct {
+			name     string
+			target   any
+			defaults any
+		}{
+			{"non-pointer target", cfg, cfg},
+			{"nil target", (*testConfig)(nil), cfg},
+			{"pointer to non-struct", Int(1), 2},
+			{"type mismatch", &cfg, testServer{}},
+			{"pointer type mismatch", &cfg, &testServer{}},
+			{"nil defaults", &cfg, nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := Defaults(tt.target, tt.defaults); err == nil {
+					t.Error("expected error")
+				}
+			})
+		}
+	})
+}
+
+type Audit struct {
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// revision is unexported so that embedding it checks that the fields of
+// unexported embedded structs are promoted, as in encoding/json.
+type revision struct {
+	Rev *int `json:"rev"`
+}
+
+type revisioned struct {
+	revision
+	Name *string `json:"name"`
+}
+
+type testUser struct {
+	Audit
+	Name     *string               `json:"name"`
+	Email    *string               `json:"email,omitempty"`
+	Age      *int                  `json:"age"`
+	Tags     []string              `json:"tags"`
+	Server   testServer            `json:"server"`
+	Backup   *testServer           `json:"backup"`
+	Created  *time.Time            `json:"created"`
+	Labels   map[string]string     `json:"labels"`
+	Servers  map[string]testServer `json:"servers"`
+	Internal *string               `json:"-"`
+	secret   *string
+}
+
+// audited embeds a pointer, whose fields encoding/json flattens as well.
+type audited struct {
+	*Audit
+	Name *string `json:"name"`
+}
+
+// listing has omitempty fields that are left out of the JSON when empty.
+type listing struct {
+	Title string   `json:"title,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	Stock int      `json:"stock,omitempty"`
+}
+
+func TestMergePatch(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		old, new any
+		want     string
+	}{
+		{
+			name: "changed, cleared and unchanged fields",
+			old:  testUser{Name: String("Alice"), Email: String("a@example.com"), Age: Int(30)},
+			new:  testUser{Name: String("Alicia"), Age: Int(30)},
+			want: `{"name":"Alicia","email":null}`,
+		},
+		{
+			name: "newly set fields",
+			old:  testUser{},
+			new:  testUser{Age: Int(0), Tags: []string{"a"}, Created: &created},
+			want: `{"age":0,"tags":["a"],"created":"2024-01-02T03:04:05Z"}`,
+		},
+		{
+			name: "nested structs",
+			old:  testUser{Server: testServer{Host: String("a"), Port: Int(1)}, Backup: &testServer{Host: String("b")}},
+			new:  testUser{Server: testServer{Host: String("a"), Port: Int(2)}, Backup: &testServer{Port: Int(3)}},
+			want: `{"server":{"Port":2},"backup":{"Host":null,"Port":3}}`,
+		},
+		{
+			name: "struct pointer set and cleared",
+			old:  &testUser{Backup: &testServer{Host: String("b")}},
+			new:  &testUser{},
+			want: `{"backup":null}`,
+		},
+		{
+			name: "map keys removed, added and changed",
+			old:  testUser{Labels: map[string]string{"a": "1", "b": "2", "c": "3"}},
+			new:  testUser{Labels: map[string]string{"a": "1", "c": "4", "d": "5"}},
+			want: `{"labels":{"b":null,"c":"4","d":"5"}}`,
+		},
+		{
+			name: "map key removed",
+			old:  testUser{Labels: map[string]string{"a": "1", "b": "2"}},
+			new:  testUser{Labels: map[string]string{"a": "1"}},
+			want: `{"labels":{"b":null}}`,
+		},
+		{
+			name: "map values patched recursively",
+			old:  testUser{Servers: map[string]testServer{"db": {Host: String("a"), Port: Int(1)}, "web": {Host: String("w")}}},
+			new:  testUser{Servers: map[string]testServer{"db": {Host: String("a"), Port: Int(2)}, "web": {Host: String("w")}}},
+			want: `{"servers":{"db":{"Port":2}}}`,
+		},
+		{
+			name: "nested maps",
+			old:  struct{ M map[string]any }{map[string]any{"x": map[string]any{"a": 1.0, "b": 2.0}}},
+			new:  struct{ M map[string]any }{map[string]any{"x": map[string]any{"a": 1.0}}},
+			want: `{"M":{"x":{"b":null}}}`,
+		},
+		{
+			name: "map set and cleared",
+			old:  testUser{Servers: map[string]testServer{"db": {}}},
+			new:  testUser{Labels: map[string]string{"a": "1"}},
+			want: `{"labels":{"a":"1"},"servers":null}`,
+		},
+		{
+			name: "embedded struct is flattened",
+			old:  testUser{},
+			new:  testUser{Audit: Audit{UpdatedBy: String("admin")}},
+			want: `{"updated_by":"admin"}`,
+		},
+		{
+			name: "unexported embedded struct is flattened",
+			old:  revisioned{revision: revision{Rev: Int(1)}},
+			new:  revisioned{revision: revision{Rev: Int(2)}, Name: String("a")},
+			want: `{"rev":2,"name":"a"}`,
+		},
+		{
+			name: "embedded pointer is flattened",
+			old:  audited{Audit: &Audit{UpdatedBy: String("admin")}},
+			new:  audited{Name: String("a")},
+			want: `{"updated_by":null,"name":"a"}`,
+		},
+		{
+			name: "omitempty fields cleared",
+			old:  listing{Title: "a", Tags: []string{"x"}, Stock: 1},
+			new:  listing{},
+			want: `{"title":null,"tags":null,"stock":null}`,
+		},
+		{
+			name: "omitempty fields empty on both sides",
+			old:  listing{Tags: []string{}},
+			new:  listing{Stock: 2},
+			want: `{"stock":2}`,
+		},
+		{
+			name: "ignored fields",
+			old:  testUser{},
+			new:  testUser{Internal: String("x"), secret: String("y")},
+			want: `{}`,
+		},
+		{
+			name: "nil old pointer",
+			old:  (*testUser)(nil),
+			new:  &testUser{Name: String("Bob")},
+			want: `{"name":"Bob"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergePatch(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		for _, args := range [][2]any{
+			{testUser{}, testServer{}},
+			{testUser{}, &testUser{}},
+			{1, 2},
+			{nil, testUser{}},
+		} {
+			if _, err := MergePatch(args[0], args[1]); err == nil {
+				t.Errorf("expected error for %T and %T", args[0], args[1])
+			}
+		}
+	})
+}
+
+func TestApplyPatch(t *testing.T) {
+	type user struct {
+		Audit
+		Name   string      `json:"name"`
+		Email  *string     `json:"email"`
+		Age    int         `json:"age"`
+		Server *testServer `json:"server"`
+	}
+	type userPatch struct {
+		Audit
+		Name   *string     `json:"name"`
+		Email  *string     `json:"email"`
+		Age    *int        `json:"age"`
+		Server *testServer `json:"server"`
+		Note   string      `json:"note"`
+	}
+
+	t.Run("assigns non-nil fields", func(t *testing.T) {
+		u := user{Name: "Alice", Age: 30}
+		patch := userPatch{
+			Audit:  Audit{UpdatedBy: String("admin")},
+			Email:  String("a@example.com"),
+			Age:    Int(31),
+			Server: &testServer{Host: String("h")},
+			Note:   "ignored",
+		}
+		if err := ApplyPatch(&u, patch); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Name != "Alice" || u.Age != 31 {
+			t.Errorf("unexpected value fields: %q %d", u.Name, u.Age)
+		}
+		if u.Email == nil || *u.Email != "a@example.com" {
+			t.Errorf("expected Email to be set, got %v", u.Email)
+		}
+		if u.Email == patch.Email || u.Server == patch.Server {
+			t.Error("expected pointer fields to be copied, not aliased")
+		}
+		if u.UpdatedBy == nil || *u.UpdatedBy != "admin" {
+			t.Errorf("expected embedded UpdatedBy to be set, got %v", u.UpdatedBy)
+		}
+	})
+
+	t.Run("unexported embedded structs", func(t *testing.T) {
+		type patch struct {
+			revision
+		}
+		u := revisioned{revision: revision{Rev: Int(1)}}
+		p := patch{revision{Rev: Int(2)}}
+		if err := ApplyPatch(&u, p); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Rev == nil || *u.Rev != 2 || u.Rev == p.Rev {
+			t.Errorf("expected a copy of Rev 2, got %v", u.Rev)
+		}
+	})
+
+	t.Run("embedded pointers", func(t *testing.T) {
+		var u audited
+		p := audited{Audit: &Audit{UpdatedBy: String("admin")}}
+		if err := ApplyPatch(&u, p); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Audit == nil || u.UpdatedBy == nil || *u.UpdatedBy != "admin" || u.Audit == p.Audit {
+			t.Errorf("expected a new Audit with UpdatedBy admin, got %+v", u.Audit)
+		}
+		if err := ApplyPatch(&u, audited{Name: String("a")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *u.UpdatedBy != "admin" || *u.Name != "a" {
+			t.Errorf("expected a nil embedded patch pointer to be skipped, got %+v", u)
+		}
+	})
+
+	t.Run("matches by json name", func(t *testing.T) {
+		type renamed struct {
+			FullName *string `json:"name"`
+		}
+		var u user
+		if err := ApplyPatch(&u, &renamed{FullName: String("Bob")}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Name != "Bob" {
+			t.Errorf("expected Bob, got %q", u.Name)
+		}
+	})
+
+	t.Run("nil patch pointer", func(t *testing.T) {
+		u := user{Name: "Alice"}
+		if err := ApplyPatch(&u, (*userPatch)(nil)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Name != "Alice" {
+			t.Error("expected dst to be unchanged")
+		}
+	})
+
+	t.Run("errors leave dst unchanged", func(t *testing.T) {
+		type badType struct {
+			Name *string `json:"name"`
+			Age  *string `json:"age"`
+		}
+		type unknown struct {
+			Name    *string `json:"name"`
+			Missing *int    `json:"missing"`
+		}
+
+		u := user{Name: "Alice"}
+		if err := ApplyPatch(&u, badType{Name: String("Bob"), Age: String("x")}); err == nil {
+			t.Error("expected type mismatch error")
+		}
+		if err := ApplyPatch(&u, unknown{Name: String("Bob"), Missing: Int(1)}); err == nil {
+			t.Error("expected unknown field error")
+		}
+		if u.Name != "Alice" {
+			t.Errorf("expected dst to be unchanged, got %q", u.Name)
+		}
+		if err := ApplyPatch(&u, unknown{}); err != nil {
+			t.Errorf("expected nil unknown fields to be ignored, got %v", err)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		var u user
+		for _, args := range [][2]any{
+			{u, userPatch{}},
+			{(*user)(nil), userPatch{}},
+			{Int(1), userPatch{}},
+			{&u, 1},
+			{&u, nil},
+		} {
+			if err := ApplyPatch(args[0], args[1]); err == nil {
+				t.Errorf("expected error for %T and %T", args[0], args[1])
+			}
+		}
+	})
+}
+
+func TestOverlay(t *testing.T) {
+	base := testConfig{
+		Name:    String("base"),
+		Debug:   Bool(false),
+		Retries: 3,
+		Server:  testServer{Host: String("localhost"), Port: Int(8080)},
+		Backup:  &testServer{Host: String("backup"), Port: Int(1)},
+		secret:  String("s"),
+	}
+	override := testConfig{
+		Debug:   Bool(true),
+		Retries: 5,
+		Server:  testServer{Port: Int(9090)},
+		Backup:  &testServer{Port: Int(2)},
+	}
+
+	got := Overlay(base, override)
+
+	if got.Name != base.Name {
+		t.Error("expected Name from base")
+	}
+	if got.Debug != override.Debug {
+		t.Error("expected Debug from override")
+	}
+	if got.Retries != 3 {
+		t.Errorf("expected non-pointer Retries from base, got %d", got.Retries)
+	}
+	if *got.Server.Host != "localhost" || *got.Server.Port != 9090 {
+		t.Errorf("unexpected Server: %s:%d", *got.Server.Host, *got.Server.Port)
+	}
+	if got.Backup == base.Backup || got.Backup == override.Backup {
+		t.Error("expected a new merged Backup")
+	}
+	if *got.Backup.Host != "backup" || *got.Backup.Port != 2 {
+		t.Errorf("unexpected Backup: %s:%d", *got.Backup.Host, *got.Backup.Port)
+	}
+	if got.secret != base.secret {
+		t.Error("expected unexported field from base")
+	}
+	if *base.Backup.Port != 1 || base.Server.Port == nil || *base.Server.Port != 8080 {
+		t.Error("expected base to be unmodified")
+	}
+
+	t.Run("pointer type", func(t *testing.T) {
+		got := Overlay(&testServer{Host: String("a")}, &testServer{Port: Int(1)})
+		if *got.Host != "a" || *got.Port != 1 {
+			t.Errorf("unexpected result: %s:%d", *got.Host, *got.Port)
+		}
+		if Overlay[*testServer](nil, nil) != nil {
+			t.Error("expected nil for two nil pointers")
+		}
+	})
+}
+
+func TestOverlayN(t *testing.T) {
+	defaults := testConfig{
+		Name:   String("app"),
+		Debug:  Bool(false),
+		Server: testServer{Host: String("localhost"), Port: Int(8080)},
+	}
+	file := testConfig{Server: testServer{Port: Int(9090)}, Backup: &testServer{Host: String("backup")}}
+	env := testConfig{Debug: Bool(true), Backup: &testServer{Port: Int(1)}}
+
+	got, prov := OverlayN(defaults, file, env)
+
+	if *got.Name != "app" || !*got.Debug || *got.Server.Host != "localhost" || *got.Server.Port != 9090 {
+		t.Errorf("unexpected result: %+v", got)
+	}
+	if *got.Backup.Host != "backup" || *got.Backup.Port != 1 {
+		t.Errorf("unexpected Backup: %s:%d", *got.Backup.Host, *got.Backup.Port)
+	}
+
+	want := Provenance{
+		"Name":        0,
+		"Debug":       2,
+		"Server.Host": 0,
+		"Server.Port": 1,
+		"Backup":      2,
+		"Backup.Host": 1,
+		"Backup.Port": 2,
+	}
+	if len(prov) != len(want) {
+		t.Errorf("OverlayN() provenance = %v, want %v", prov, want)
+	}
+	for path, layer := range want {
+		if got, ok := prov[path]; !ok || got != layer {
+			t.Errorf("prov[%q] = %d, %v; want %d", path, got, ok, layer)
+		}
+	}
+
+	t.Run("no layers", func(t *testing.T) {
+		got, prov := OverlayN[testConfig]()
+		if got.Name != nil || len(prov) != 0 {
+			t.Errorf("OverlayN() = %+v, %v; want zero value and empty provenance", got, prov)
+		}
+	})
+}
+
+// aliasRoot reaches an aliasOuter both directly and through a pointer to its
+// first field, which shares the outer struct's address.
+type aliasInner struct{ X *int }
+
+type aliasOuter struct {
+	In aliasInner
+	Y  *int
+}
+
+type aliasRoot struct {
+	PIn  *aliasInner
+	POut *aliasOuter
+}
+
+func TestNilZeroFields(t *testing.T) {
+	type node struct {
+		Value *int
+		Next  *node
+	}
+
+	cfg := testConfig{
+		Name:   String(""),
+		Debug:  Bool(true),
+		Server: testServer{Host: String(""), Port: Int(8080)},
+		Backup: &testServer{Host: String("backup"), Port: Int(0)},
+		secret: String(""),
+	}
+	NilZeroFields(&cfg)
+
+	if cfg.Name != nil || cfg.Server.Host != nil || cfg.Backup.Port != nil {
+		t.Errorf("expected zero pointees to become nil, got %+v", cfg)
+	}
+	if cfg.Debug == nil || cfg.Server.Port == nil || cfg.Backup.Host == nil {
+		t.Errorf("expected non-zero pointees to be kept, got %+v", cfg)
+	}
+	if cfg.secret == nil {
+		t.Error("expected unexported field to be ignored")
+	}
+
+	t.Run("zero struct pointer", func(t *testing.T) {
+		cfg := testConfig{Backup: &testServer{}}
+		NilZeroFields(&cfg)
+		if cfg.Backup != nil {
+			t.Error("expected pointer to zero struct to become nil")
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		n := &node{Value: Int(1)}
+		n.Next = n
+		NilZeroFields(n)
+		if n.Next != n {
+			t.Error("expected cycle to be preserved")
+		}
+	})
+
+	t.Run("struct aliased by its first field", func(t *testing.T) {
+		o := &aliasOuter{In: aliasInner{X: Int(0)}, Y: Int(0)}
+		NilZeroFields(&aliasRoot{PIn: &o.In, POut: o})
+		if o.In.X != nil || o.Y != nil {
+			t.Errorf("NilZeroFields() left X=%v Y=%v, want both nil", o.In.X, o.Y)
+		}
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for non-pointer argument")
+			}
+		}()
+		NilZeroFields(cfg)
+	})
+}
+
+func TestZeroNilFields(t *testing.T) {
+	type node struct {
+		Value *int
+		Next  *node
+	}
+
+	cfg := testConfig{Name: String("app")}
+	ZeroNilFields(&cfg)
+
+	if *cfg.Name != "app" {
+		t.Errorf("expected set field to be kept, got %q", *cfg.Name)
+	}
+	if cfg.Debug == nil || *cfg.Debug {
+		t.Errorf("expected pointer to false, got %v", cfg.Debug)
+	}
+	if cfg.Server.Host == nil || cfg.Server.Port == nil {
+		t.Errorf("expected nested struct fields to be filled, got %+v", cfg.Server)
+	}
+	if cfg.Backup == nil || cfg.Backup.Host == nil || *cfg.Backup.Port != 0 {
+		t.Errorf("expected new Backup with filled fields, got %+v", cfg.Backup)
+	}
+	if cfg.secret != nil {
+		t.Error("expected unexported field to be ignored")
+	}
+
+	t.Run("recursive type", func(t *testing.T) {
+		n := &node{Next: &node{}}
+		ZeroNilFields(n)
+		if n.Value == nil || n.Next.Value == nil {
+			t.Error("expected Value fields to be filled")
+		}
+		if n.Next.Next != nil {
+			t.Error("expected recursive pointer to stay nil")
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		n := &node{}
+		n.Next = n
+		ZeroNilFields(n)
+		if n.Next != n || n.Value == nil {
+			t.Errorf("expected cycle to be preserved and Value filled, got %+v", n)
+		}
+	})
+
+	t.Run("struct aliased by its first field", func(t *testing.T) {
+		o := &aliasOuter{}
+		ZeroNilFields(&aliasRoot{PIn: &o.In, POut: o})
+		if o.In.X == nil || o.Y == nil {
+			t.Errorf("ZeroNilFields() left X=%v Y=%v, want both non-nil", o.In.X, o.Y)
+		}
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for nil pointer argument")
+			}
+		}()
+		ZeroNilFields((*testConfig)(nil))
+	})
+}
+
+func TestNilFields(t *testing.T) {
+	u := testUser{
+		Name:    String("Alice"),
+		Server:  testServer{Port: Int(80)},
+		Backup:  &testServer{Host: String("backup")},
+		Created: Time(time.Now()),
+	}
+	got := NilFields(u)
+	want := []string{"updated_by", "email", "age", "server.Host", "backup.Port"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NilFields() = %v, want %v", got, want)
+	}
+
+	if got := NilFields(revisioned{Name: String("a")}); !reflect.DeepEqual(got, []string{"rev"}) {
+		t.Errorf("NilFields() = %v, want [rev]", got)
+	}
+
+	if got := NilFields(&testServer{Host: String("a"), Port: Int(1)}); len(got) != 0 {
+		t.Errorf("NilFields() = %v, want none", got)
+	}
+	if got := NilFields((*testServer)(nil)); !reflect.DeepEqual(got, []string{"Host", "Port"}) {
+		t.Errorf("NilFields(nil) = %v, want [Host Port]", got)
+	}
+
+	if got := NilFields(audited{}); !reflect.DeepEqual(got, []string{"updated_by", "name"}) {
+		t.Errorf("NilFields() = %v, want [updated_by name]", got)
+	}
+
+	o := &aliasOuter{}
+	got = NilFields(aliasRoot{PIn: &o.In, POut: o})
+	want = []string{"PIn.X", "POut.In.X", "POut.Y"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NilFields(alias) = %v, want %v", got, want)
+	}
+
+	t.Run("invalid argument", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for non-struct argument")
+			}
+		}()
+		NilFields(42)
+	})
+}
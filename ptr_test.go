@@ -1,10 +1,44 @@
 package ptr
 
 import (
+	"errors"
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
 
+type cloneDoc struct {
+	Tags []string
+}
+
+func (d cloneDoc) Clone() cloneDoc {
+	return cloneDoc{Tags: append([]string(nil), d.Tags...)}
+}
+
+type deepCopyDoc struct {
+	Tags []string
+}
+
+func (d *deepCopyDoc) DeepCopy() deepCopyDoc {
+	return deepCopyDoc{Tags: append([]string(nil), d.Tags...)}
+}
+
+// generatedDoc has a DeepCopy method in the style of Kubernetes code
+// generators, which returns a pointer.
+type generatedDoc struct {
+	Tags []string
+}
+
+func (in *generatedDoc) DeepCopy() *generatedDoc {
+	if in == nil {
+		return nil
+	}
+	out := new(generatedDoc)
+	out.Tags = append([]string(nil), in.Tags...)
+	return out
+}
+
 // Test generic To function
 func TestTo(t *testing.T) {
 	t.Run("string", func(t *testing.T) {
@@ -558,6 +592,79 @@ func TestMustFrom(t *testing.T) {
 	})
 }
 
+// Test Expect function
+func TestExpect(t *testing.T) {
+	t.Run("non-nil", func(t *testing.T) {
+		if result := Expect(To("hello"), "greeting must be set"); result != "hello" {
+			t.Errorf("expected hello, got %q", result)
+		}
+	})
+
+	t.Run("nil panics with message and type", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			want := "ptr: user.Email must be set (nil *string)"
+			if r != want {
+				t.Errorf("expected panic %q, got %v", want, r)
+			}
+		}()
+		_ = Expect[string](nil, "user.Email must be set")
+	})
+}
+
+// Test TryFrom function
+func TestTryFrom(t *testing.T) {
+	v, err := TryFrom(To("hello"))
+	if err != nil || v != "hello" {
+		t.Errorf("TryFrom() = %q, %v; want hello, nil", v, err)
+	}
+
+	v, err = TryFrom[string](nil)
+	if !errors.Is(err, ErrNilPointer) {
+		t.Errorf("expected ErrNilPointer, got %v", err)
+	}
+	if v != "" {
+		t.Errorf("expected zero value, got %q", v)
+	}
+}
+
+// Test Require function
+func TestRequire(t *testing.T) {
+	v, err := Require(To("a@example.com"), "email")
+	if err != nil || v != "a@example.com" {
+		t.Errorf("Require() = %q, %v; want a@example.com, nil", v, err)
+	}
+
+	v, err = Require[string](nil, "email")
+	if err == nil {
+		t.Fatal("expected error for nil pointer")
+	}
+	if want := `field "email" is required`; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
+	}
+	if !errors.Is(err, ErrNilPointer) {
+		t.Error("expected error to match ErrNilPointer")
+	}
+	if v != "" {
+		t.Errorf("expected zero value, got %q", v)
+	}
+}
+
+// Test Zero function
+func TestZero(t *testing.T) {
+	i := Zero[int]()
+	if i == nil || *i != 0 {
+		t.Errorf("Zero[int]() = %v, want pointer to 0", i)
+	}
+	s := Zero[string]()
+	if s == nil || *s != "" {
+		t.Errorf("Zero[string]() = %v, want pointer to empty string", s)
+	}
+	if Zero[int]() == i {
+		t.Error("expected a fresh allocation on each call")
+	}
+}
+
 // Test type-specific Int8 functions
 func TestInt8(t *testing.T) {
 	v := int8(42)
@@ -1735,6 +1842,28 @@ func TestSwap(t *testing.T) {
 	})
 }
 
+// Test Rust-style Unwrap aliases
+func TestUnwrapAliases(t *testing.T) {
+	if result := UnwrapOr(To(1), 2); result != 1 {
+		t.Errorf("UnwrapOr() = %d, want 1", result)
+	}
+	if result := UnwrapOr(nil, 2); result != 2 {
+		t.Errorf("UnwrapOr(nil) = %d, want 2", result)
+	}
+	if result := UnwrapOrDefault(To("x")); result != "x" {
+		t.Errorf("UnwrapOrDefault() = %q, want x", result)
+	}
+	if result := UnwrapOrDefault[string](nil); result != "" {
+		t.Errorf("UnwrapOrDefault(nil) = %q, want empty", result)
+	}
+	if result := UnwrapOrElse(To(1), func() int { return 2 }); result != 1 {
+		t.Errorf("UnwrapOrElse() = %d, want 1", result)
+	}
+	if result := UnwrapOrElse(nil, func() int { return 2 }); result != 2 {
+		t.Errorf("UnwrapOrElse(nil) = %d, want 2", result)
+	}
+}
+
 // Test Bind function
 func TestBind(t *testing.T) {
 	t.Run("successful bind", func(t *testing.T) {
@@ -1896,3 +2025,902 @@ func TestMustFloat64(t *testing.T) {
 		MustFloat64(nil)
 	})
 }
+
+// Test Wrap function
+func TestWrap(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		p, err := Wrap(42, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p == nil || *p != 42 {
+			t.Errorf("expected pointer to 42, got %v", p)
+		}
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		wantErr := errors.New("boom")
+		p, err := Wrap(42, wantErr)
+		if err != wantErr {
+			t.Errorf("expected %v, got %v", wantErr, err)
+		}
+		if p != nil {
+			t.Error("expected nil pointer")
+		}
+	})
+}
+
+// Test WrapOk function
+func TestWrapOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	v, ok := m["a"]
+	if p := WrapOk(v, ok); p == nil || *p != 1 {
+		t.Errorf("expected pointer to 1, got %v", p)
+	}
+
+	v, ok = m["missing"]
+	if p := WrapOk(v, ok); p != nil {
+		t.Error("expected nil for missing key")
+	}
+}
+
+// Test Unwrap function
+func TestUnwrap(t *testing.T) {
+	t.Run("non-nil pointer", func(t *testing.T) {
+		v, err := Unwrap(To("hello"), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != "hello" {
+			t.Errorf("expected 'hello', got %q", v)
+		}
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		v, err := Unwrap[string](nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != "" {
+			t.Errorf("expected empty string, got %q", v)
+		}
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		wantErr := errors.New("boom")
+		v, err := Unwrap(To(42), wantErr)
+		if err != wantErr {
+			t.Errorf("expected %v, got %v", wantErr, err)
+		}
+		if v != 0 {
+			t.Errorf("expected 0, got %d", v)
+		}
+	})
+
+	t.Run("round trip with Wrap", func(t *testing.T) {
+		v, err := Unwrap(Wrap(7, nil))
+		if err != nil || v != 7 {
+			t.Errorf("expected 7, nil; got %d, %v", v, err)
+		}
+	})
+}
+
+// Test Clone function
+func TestClone(t *testing.T) {
+	t.Run("nil pointer", func(t *testing.T) {
+		if Clone[cloneDoc](nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+
+	t.Run("uses Clone method", func(t *testing.T) {
+		original := To(cloneDoc{Tags: []string{"a"}})
+		c := Clone(original)
+		original.Tags[0] = "b"
+		if c == original || c.Tags[0] != "a" {
+			t.Errorf("expected independent copy, got %v", c.Tags)
+		}
+	})
+
+	t.Run("uses DeepCopy method on pointer receiver", func(t *testing.T) {
+		original := To(deepCopyDoc{Tags: []string{"a"}})
+		c := Clone(original)
+		original.Tags[0] = "b"
+		if c == original || c.Tags[0] != "a" {
+			t.Errorf("expected independent copy, got %v", c.Tags)
+		}
+	})
+
+	t.Run("uses generated DeepCopy method returning a pointer", func(t *testing.T) {
+		original := To(generatedDoc{Tags: []string{"a"}})
+		c := Clone(original)
+		original.Tags[0] = "b"
+		if c == nil || c == original || c.Tags[0] != "a" {
+			t.Errorf("expected independent copy, got %v", c)
+		}
+	})
+
+	t.Run("falls back to shallow copy", func(t *testing.T) {
+		type plain struct{ Tags []string }
+		original := To(plain{Tags: []string{"a"}})
+		c := Clone(original)
+		if c == original {
+			t.Error("expected new pointer")
+		}
+		original.Tags[0] = "b"
+		if c.Tags[0] != "b" {
+			t.Error("expected shallow copy to share slice")
+		}
+	})
+}
+
+// Test EqualFunc function
+func TestEqualFunc(t *testing.T) {
+	caseInsensitive := func(a, b string) bool { return strings.EqualFold(a, b) }
+	tolerance := func(a, b float64) bool { return math.Abs(a-b) < 0.001 }
+
+	t.Run("both nil", func(t *testing.T) {
+		called := false
+		result := EqualFunc[string](nil, nil, func(a, b string) bool {
+			called = true
+			return false
+		})
+		if !result {
+			t.Error("expected true")
+		}
+		if called {
+			t.Error("eq should not be called for nil pointers")
+		}
+	})
+
+	t.Run("one nil", func(t *testing.T) {
+		if EqualFunc(To("a"), nil, caseInsensitive) {
+			t.Error("expected false")
+		}
+		if EqualFunc(nil, To("a"), caseInsensitive) {
+			t.Error("expected false")
+		}
+	})
+
+	t.Run("custom equality", func(t *testing.T) {
+		if !EqualFunc(To("Hello"), To("hello"), caseInsensitive) {
+			t.Error("expected case-insensitive match")
+		}
+		if EqualFunc(To("Hello"), To("world"), caseInsensitive) {
+			t.Error("expected mismatch")
+		}
+		if !EqualFunc(To(0.1+0.2), To(0.3), tolerance) {
+			t.Error("expected float tolerance match")
+		}
+	})
+
+	t.Run("non-comparable type", func(t *testing.T) {
+		sameLen := func(a, b []int) bool { return len(a) == len(b) }
+		if !EqualFunc(To([]int{1, 2}), To([]int{3, 4}), sameLen) {
+			t.Error("expected true")
+		}
+	})
+}
+
+// Test EqualBy function
+func TestEqualBy(t *testing.T) {
+	utc := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	other := utc.In(time.FixedZone("UTC+2", 2*60*60))
+	later := utc.Add(time.Second)
+
+	tests := []struct {
+		name string
+		a, b *time.Time
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"first nil", nil, &utc, false},
+		{"second nil", &utc, nil, false},
+		{"same instant different location", &utc, &other, true},
+		{"different instants", &utc, &later, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualBy(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualBy() = %v, want %v", got, tt.want)
+			}
+			if got := EqualTime(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("monotonic clock", func(t *testing.T) {
+		now := time.Now()
+		stripped := now.Round(0)
+		if Equal(&now, &stripped) {
+			t.Skip("== unexpectedly treats monotonic readings as equal")
+		}
+		if !EqualTime(&now, &stripped) {
+			t.Error("expected EqualTime to ignore monotonic clock reading")
+		}
+	})
+}
+
+// Test Min and Max functions
+func TestMinMax(t *testing.T) {
+	one, two, three := To(1), To(2), To(3)
+	otherOne := To(1)
+
+	tests := []struct {
+		name    string
+		input   []*int
+		wantMin *int
+		wantMax *int
+	}{
+		{"no arguments", nil, nil, nil},
+		{"all nil", []*int{nil, nil}, nil, nil},
+		{"single", []*int{two}, two, two},
+		{"skips nils", []*int{nil, three, nil, one, two}, one, three},
+		{"ties return first", []*int{one, otherOne}, one, one},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Min(tt.input...); got != tt.wantMin {
+				t.Errorf("Min() = %v, want %v", got, tt.wantMin)
+			}
+			if got := Max(tt.input...); got != tt.wantMax {
+				t.Errorf("Max() = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+
+	t.Run("strings and floats", func(t *testing.T) {
+		if got := Min(To("b"), To("a")); *got != "a" {
+			t.Errorf("expected a, got %s", *got)
+		}
+		if got := Max(To(1.5), nil, To(-2.0)); *got != 1.5 {
+			t.Errorf("expected 1.5, got %f", *got)
+		}
+	})
+}
+
+// Test FromSliceNonNil function
+func TestFromSliceNonNil(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []*int
+		want  []int
+	}{
+		{"nil slice", nil, nil},
+		{"empty slice", []*int{}, []int{}},
+		{"all nil", []*int{nil, nil}, []int{}},
+		{"keeps zero values", []*int{To(1), nil, To(0)}, []int{1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FromSliceNonNil(tt.input)
+			if (tt.want == nil) != (result == nil) {
+				t.Fatalf("FromSliceNonNil(%v) = %v, want %v", tt.input, result, tt.want)
+			}
+			if len(result) != len(tt.want) {
+				t.Fatalf("FromSliceNonNil(%v) length = %d, want %d", tt.input, len(result), len(tt.want))
+			}
+			for i, v := range result {
+				if v != tt.want[i] {
+					t.Errorf("FromSliceNonNil(%v)[%d] = %d, want %d", tt.input, i, v, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// Test Zip and Unzip functions
+func TestZip(t *testing.T) {
+	t.Run("both present", func(t *testing.T) {
+		p := Zip(To("alice"), To(30))
+		if p == nil {
+			t.Fatal("expected non-nil")
+		}
+		if p.First != "alice" || p.Second != 30 {
+			t.Errorf("Zip() = %+v, want {alice 30}", *p)
+		}
+	})
+
+	t.Run("either nil", func(t *testing.T) {
+		if Zip(To("alice"), (*int)(nil)) != nil {
+			t.Error("expected nil when second is nil")
+		}
+		if Zip((*string)(nil), To(30)) != nil {
+			t.Error("expected nil when first is nil")
+		}
+	})
+
+	t.Run("unzip", func(t *testing.T) {
+		pair := &Pair[string, int]{First: "bob", Second: 40}
+		a, b := Unzip(pair)
+		if a == nil || *a != "bob" || b == nil || *b != 40 {
+			t.Errorf("Unzip() = %v, %v; want bob, 40", a, b)
+		}
+		*a = "changed"
+		if pair.First != "bob" {
+			t.Error("expected Unzip to return copies")
+		}
+	})
+
+	t.Run("unzip nil", func(t *testing.T) {
+		a, b := Unzip[string, int](nil)
+		if a != nil || b != nil {
+			t.Errorf("Unzip(nil) = %v, %v; want nil, nil", a, b)
+		}
+	})
+}
+
+// Test Map2 function
+func TestMap2(t *testing.T) {
+	area := func(w, h int) int { return w * h }
+
+	t.Run("both present", func(t *testing.T) {
+		result := Map2(To(3), To(4), area)
+		if result == nil || *result != 12 {
+			t.Errorf("Map2() = %v, want 12", result)
+		}
+	})
+
+	t.Run("either nil", func(t *testing.T) {
+		called := false
+		fn := func(a string, b int) bool {
+			called = true
+			return true
+		}
+		if Map2(nil, To(1), fn) != nil {
+			t.Error("expected nil when first is nil")
+		}
+		if Map2(To("a"), nil, fn) != nil {
+			t.Error("expected nil when second is nil")
+		}
+		if called {
+			t.Error("function should not be called when an input is nil")
+		}
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		result := Map2(To("ab"), To(3), strings.Repeat)
+		if result == nil || *result != "ababab" {
+			t.Errorf("Map2() = %v, want ababab", result)
+		}
+	})
+}
+
+// Test Xor function
+func TestXor(t *testing.T) {
+	a, b := To(1), To(2)
+
+	tests := []struct {
+		name string
+		a, b *int
+		want *int
+	}{
+		{"both nil", nil, nil, nil},
+		{"only first", a, nil, a},
+		{"only second", nil, b, b},
+		{"both set", a, b, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Xor(tt.a, tt.b); got != tt.want {
+				t.Errorf("Xor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test And function
+func TestAnd(t *testing.T) {
+	token := To("secret")
+	override := To(42)
+
+	if got := And(token, override); got != override {
+		t.Errorf("And(set, set) = %v, want %v", got, override)
+	}
+	if got := And[string](nil, override); got != nil {
+		t.Errorf("And(nil, set) = %v, want nil", got)
+	}
+	if got := And[string, int](token, nil); got != nil {
+		t.Errorf("And(set, nil) = %v, want nil", got)
+	}
+}
+
+// Test OrElseGet function
+func TestOrElseGet(t *testing.T) {
+	t.Run("non-nil skips supplier", func(t *testing.T) {
+		p := To(1)
+		called := false
+		result := OrElseGet(p, func() *int {
+			called = true
+			return To(2)
+		})
+		if result != p {
+			t.Error("expected original pointer")
+		}
+		if called {
+			t.Error("supplier should not be called for non-nil pointer")
+		}
+	})
+
+	t.Run("nil calls supplier", func(t *testing.T) {
+		fallback := To(2)
+		if result := OrElseGet(nil, func() *int { return fallback }); result != fallback {
+			t.Errorf("OrElseGet() = %v, want %v", result, fallback)
+		}
+	})
+
+	t.Run("supplier may return nil", func(t *testing.T) {
+		if result := OrElseGet(nil, func() *int { return nil }); result != nil {
+			t.Errorf("OrElseGet() = %v, want nil", result)
+		}
+	})
+}
+
+// Test FromOrFunc function
+func TestFromOrFunc(t *testing.T) {
+	t.Run("non-nil skips default", func(t *testing.T) {
+		called := false
+		result := FromOrFunc(To(42), func() int {
+			called = true
+			return 100
+		})
+		if result != 42 {
+			t.Errorf("expected 42, got %d", result)
+		}
+		if called {
+			t.Error("default function should not be called for non-nil pointer")
+		}
+	})
+
+	t.Run("nil calls default", func(t *testing.T) {
+		if result := FromOrFunc(nil, func() string { return "default" }); result != "default" {
+			t.Errorf("expected default, got %s", result)
+		}
+	})
+}
+
+// Test MapOr function
+func TestMapOr(t *testing.T) {
+	strlen := func(s string) int { return len(s) }
+
+	if result := MapOr(To("hello"), -1, strlen); result != 5 {
+		t.Errorf("expected 5, got %d", result)
+	}
+	if result := MapOr(nil, -1, strlen); result != -1 {
+		t.Errorf("expected -1, got %d", result)
+	}
+}
+
+// Test MapOrElse function
+func TestMapOrElse(t *testing.T) {
+	var defCalls, fnCalls int
+	def := func() string {
+		defCalls++
+		return "default"
+	}
+	fn := func(s string) string {
+		fnCalls++
+		return strings.ToUpper(s)
+	}
+
+	if result := MapOrElse(To("hello"), def, fn); result != "HELLO" {
+		t.Errorf("expected HELLO, got %s", result)
+	}
+	if defCalls != 0 || fnCalls != 1 {
+		t.Errorf("expected only fn to be called, got defCalls=%d fnCalls=%d", defCalls, fnCalls)
+	}
+
+	if result := MapOrElse(nil, def, fn); result != "default" {
+		t.Errorf("expected default, got %s", result)
+	}
+	if defCalls != 1 || fnCalls != 1 {
+		t.Errorf("expected only defFn to be called, got defCalls=%d fnCalls=%d", defCalls, fnCalls)
+	}
+}
+
+// Test Inspect function
+func TestInspect(t *testing.T) {
+	var seen []int
+	record := func(v int) { seen = append(seen, v) }
+
+	p := To(42)
+	if result := Inspect(p, record); result != p {
+		t.Error("expected the same pointer to be returned")
+	}
+	if result := Inspect(nil, record); result != nil {
+		t.Errorf("expected nil, got %v", result)
+	}
+	if len(seen) != 1 || seen[0] != 42 {
+		t.Errorf("expected fn to be called once with 42, got %v", seen)
+	}
+}
+
+// Test Tap function
+func TestTap(t *testing.T) {
+	var seen []*int
+	record := func(p *int) { seen = append(seen, p) }
+
+	p := To(42)
+	if result := Tap(p, record); result != p {
+		t.Error("expected the same pointer to be returned")
+	}
+	if result := Tap(nil, record); result != nil {
+		t.Errorf("expected nil, got %v", result)
+	}
+	if len(seen) != 2 || seen[0] != p || seen[1] != nil {
+		t.Errorf("expected fn to be called with both pointers, got %v", seen)
+	}
+}
+
+// Test Take function
+func TestTake(t *testing.T) {
+	v := To(42)
+	field := v
+	if result := Take(&field); result != v {
+		t.Error("expected the original pointer")
+	}
+	if field != nil {
+		t.Error("expected field to be cleared")
+	}
+	if result := Take(&field); result != nil {
+		t.Errorf("expected nil on second Take, got %v", result)
+	}
+	if result := Take[int](nil); result != nil {
+		t.Errorf("expected nil for nil pp, got %v", result)
+	}
+}
+
+// Test Replace function
+func TestReplace(t *testing.T) {
+	var field *string
+	if old := Replace(&field, "first"); old != nil {
+		t.Errorf("expected nil previous pointer, got %v", old)
+	}
+	if field == nil || *field != "first" {
+		t.Fatalf("expected field to be first, got %v", field)
+	}
+
+	prev := field
+	if old := Replace(&field, "second"); old != prev {
+		t.Error("expected the previous pointer to be returned")
+	}
+	if *field != "second" || *prev != "first" {
+		t.Errorf("expected field second and previous first, got %s and %s", *field, *prev)
+	}
+
+	if old := Replace[string](nil, "x"); old != nil {
+		t.Errorf("expected nil for nil pp, got %v", old)
+	}
+}
+
+// Test Exchange function
+func TestExchange(t *testing.T) {
+	p := To(42)
+	old, ok := Exchange(p, 100)
+	if !ok || old != 42 {
+		t.Errorf("Exchange() = %d, %v; want 42, true", old, ok)
+	}
+	if *p != 100 {
+		t.Errorf("expected 100, got %d", *p)
+	}
+
+	old, ok = Exchange[int](nil, 100)
+	if ok || old != 0 {
+		t.Errorf("Exchange(nil) = %d, %v; want 0, false", old, ok)
+	}
+}
+
+// Test Contains function
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *string
+		v    string
+		want bool
+	}{
+		{"equal", To("admin"), "admin", true},
+		{"different", To("user"), "admin", false},
+		{"nil", nil, "admin", false},
+		{"nil with zero value", nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Contains(tt.p, tt.v); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test ContainsFunc function
+func TestContainsFunc(t *testing.T) {
+	adult := func(age int) bool { return age >= 18 }
+
+	if !ContainsFunc(To(30), adult) {
+		t.Error("expected true for matching value")
+	}
+	if ContainsFunc(To(10), adult) {
+		t.Error("expected false for non-matching value")
+	}
+	if ContainsFunc(nil, func(int) bool {
+		t.Error("predicate should not be called for nil pointer")
+		return true
+	}) {
+		t.Error("expected false for nil pointer")
+	}
+}
+
+// Test Value function
+func TestValue(t *testing.T) {
+	v, ok := Value(To(0))
+	if !ok || v != 0 {
+		t.Errorf("Value() = %d, %v; want 0, true", v, ok)
+	}
+
+	v, ok = Value[int](nil)
+	if ok || v != 0 {
+		t.Errorf("Value(nil) = %d, %v; want 0, false", v, ok)
+	}
+}
+
+// Test MustSet function
+func TestMustSet(t *testing.T) {
+	t.Run("non-nil", func(t *testing.T) {
+		p := To(42)
+		MustSet(p, 100)
+		if *p != 100 {
+			t.Errorf("expected 100, got %d", *p)
+		}
+	})
+
+	t.Run("nil panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for nil pointer")
+			}
+		}()
+		MustSet[int](nil, 100)
+	})
+}
+
+// Test SetIfNil function
+func TestSetIfNil(t *testing.T) {
+	var port *int
+	if !SetIfNil(&port, 8080) {
+		t.Error("expected true when field is nil")
+	}
+	if port == nil || *port != 8080 {
+		t.Fatalf("expected 8080, got %v", port)
+	}
+
+	if SetIfNil(&port, 9090) {
+		t.Error("expected false when field is already set")
+	}
+	if *port != 8080 {
+		t.Errorf("expected 8080 to be kept, got %d", *port)
+	}
+
+	if SetIfNil[int](nil, 1) {
+		t.Error("expected false for nil pp")
+	}
+}
+
+// Test GetOrInit function
+func TestGetOrInit(t *testing.T) {
+	calls := 0
+	init := func() []string {
+		calls++
+		return []string{"default"}
+	}
+
+	var field *[]string
+	first := GetOrInit(&field, init)
+	if first == nil || field != first {
+		t.Fatal("expected field to be initialized and returned")
+	}
+	second := GetOrInit(&field, init)
+	if second != first {
+		t.Error("expected the same pointer on second call")
+	}
+	if calls != 1 {
+		t.Errorf("expected init to be called once, got %d", calls)
+	}
+
+	if result := GetOrInit[int](nil, func() int { return 1 }); result != nil {
+		t.Errorf("expected nil for nil pp, got %v", result)
+	}
+}
+
+// Test Flatten and From2 functions
+func TestFlatten(t *testing.T) {
+	inner := To("hello")
+	outer := &inner
+	var nilInner *string
+
+	tests := []struct {
+		name      string
+		pp        **string
+		wantPtr   *string
+		wantValue string
+	}{
+		{"both set", outer, inner, "hello"},
+		{"inner nil", &nilInner, nil, ""},
+		{"outer nil", nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.pp); got != tt.wantPtr {
+				t.Errorf("Flatten() = %v, want %v", got, tt.wantPtr)
+			}
+			if got := From2(tt.pp); got != tt.wantValue {
+				t.Errorf("From2() = %q, want %q", got, tt.wantValue)
+			}
+		})
+	}
+}
+
+// Test If and IfElse functions
+func TestIf(t *testing.T) {
+	if result := If(true, "set"); result == nil || *result != "set" {
+		t.Errorf("If(true) = %v, want pointer to set", result)
+	}
+	if result := If(false, "set"); result != nil {
+		t.Errorf("If(false) = %v, want nil", result)
+	}
+	if result := IfElse(true, "a", "b"); result == nil || *result != "a" {
+		t.Errorf("IfElse(true) = %v, want pointer to a", result)
+	}
+	if result := IfElse(false, "a", "b"); result == nil || *result != "b" {
+		t.Errorf("IfElse(false) = %v, want pointer to b", result)
+	}
+}
+
+// Test When and Unless functions
+func TestWhen(t *testing.T) {
+	p := To(42)
+	if result := When(p, true); result != p {
+		t.Errorf("When(true) = %v, want %v", result, p)
+	}
+	if result := When(p, false); result != nil {
+		t.Errorf("When(false) = %v, want nil", result)
+	}
+	if result := Unless(p, false); result != p {
+		t.Errorf("Unless(false) = %v, want %v", result, p)
+	}
+	if result := Unless(p, true); result != nil {
+		t.Errorf("Unless(true) = %v, want nil", result)
+	}
+	if result := When[int](nil, true); result != nil {
+		t.Errorf("When(nil, true) = %v, want nil", result)
+	}
+}
+
+// Test CoalesceValue function
+func TestCoalesceValue(t *testing.T) {
+	tests := []struct {
+		name string
+		ptrs []*int
+		want int
+	}{
+		{"first non-nil", []*int{nil, To(1), To(2)}, 1},
+		{"zero value is kept", []*int{To(0), To(2)}, 0},
+		{"all nil", []*int{nil, nil}, 8080},
+		{"no pointers", nil, 8080},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CoalesceValue(8080, tt.ptrs...); got != tt.want {
+				t.Errorf("CoalesceValue() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test CoalesceFunc function
+func TestCoalesceFunc(t *testing.T) {
+	var calls []string
+	source := func(name string, p *string) func() *string {
+		return func() *string {
+			calls = append(calls, name)
+			return p
+		}
+	}
+
+	result := CoalesceFunc(
+		source("env", nil),
+		source("file", To("from file")),
+		source("remote", To("from remote")),
+	)
+	if result == nil || *result != "from file" {
+		t.Errorf("expected from file, got %v", result)
+	}
+	if len(calls) != 2 || calls[0] != "env" || calls[1] != "file" {
+		t.Errorf("expected env and file to be called, got %v", calls)
+	}
+
+	if result := CoalesceFunc(source("env", nil)); result != nil {
+		t.Errorf("expected nil, got %v", result)
+	}
+	if result := CoalesceFunc[string](); result != nil {
+		t.Errorf("expected nil for no functions, got %v", result)
+	}
+}
+
+// Test NonZeroOr function
+func TestNonZeroOr(t *testing.T) {
+	if result := NonZeroOr(10, 50); result == nil || *result != 10 {
+		t.Errorf("NonZeroOr(10, 50) = %v, want pointer to 10", result)
+	}
+	if result := NonZeroOr(0, 50); result == nil || *result != 50 {
+		t.Errorf("NonZeroOr(0, 50) = %v, want pointer to 50", result)
+	}
+	if result := NonZeroOr("", ""); result == nil || *result != "" {
+		t.Errorf("NonZeroOr(\"\", \"\") = %v, want pointer to empty string", result)
+	}
+}
+
+// Test OrZero function
+func TestOrZero(t *testing.T) {
+	p := To(42)
+	if result := OrZero(p); result != p {
+		t.Error("expected the original pointer")
+	}
+
+	result := OrZero[int](nil)
+	if result == nil || *result != 0 {
+		t.Errorf("OrZero(nil) = %v, want pointer to 0", result)
+	}
+	if OrZero[int](nil) == result {
+		t.Error("expected a fresh allocation on each call")
+	}
+}
+
+// Test NilIfZero function
+func TestNilIfZero(t *testing.T) {
+	p := To(42)
+	if result := NilIfZero(p); result != p {
+		t.Error("expected the original pointer")
+	}
+	if result := NilIfZero(To(0)); result != nil {
+		t.Errorf("NilIfZero(0) = %v, want nil", result)
+	}
+	if result := NilIfZero[string](nil); result != nil {
+		t.Errorf("NilIfZero(nil) = %v, want nil", result)
+	}
+}
+
+// Test ToSliceCopy function
+func TestToSliceCopy(t *testing.T) {
+	values := []int{1, 2, 3}
+
+	aliased := ToSlice(values)
+	*aliased[0] = 10
+	if values[0] != 10 {
+		t.Errorf("expected ToSlice to alias the input, got values[0] = %d", values[0])
+	}
+
+	copied := ToSliceCopy(values)
+	if len(copied) != 3 || *copied[0] != 10 || *copied[1] != 2 || *copied[2] != 3 {
+		t.Fatalf("unexpected ToSliceCopy result")
+	}
+	*copied[1] = 20
+	if values[1] != 2 {
+		t.Errorf("expected ToSliceCopy not to alias the input, got values[1] = %d", values[1])
+	}
+
+	if ToSliceCopy[int](nil) != nil {
+		t.Error("expected nil for nil input")
+	}
+	if result := ToSliceCopy([]int{}); result == nil || len(result) != 0 {
+		t.Errorf("expected empty non-nil slice, got %v", result)
+	}
+}
@@ -0,0 +1,46 @@
+//go:build go1.24
+
+package ptr
+
+import "weak"
+
+// Weak is a weak reference to a value of type T, built on weak.Pointer.
+// It does not keep the value alive, so the garbage collector may reclaim it
+// under memory pressure; after that the reference behaves like a nil pointer.
+// The zero value behaves like a reference to an already reclaimed value.
+//
+// Example:
+//
+//	w := ptr.MakeWeak(loadBlob(id))
+//	if blob, ok := w.Value(); ok {
+//	    serve(blob)
+//	} else {
+//	    w = ptr.MakeWeak(loadBlob(id))  // reclaimed, load it again
+//	}
+type Weak[T any] struct {
+	wp weak.Pointer[T]
+}
+
+// MakeWeak returns a weak reference to the value p points to.
+// A nil pointer produces a reference that is always nil.
+func MakeWeak[T any](p *T) Weak[T] {
+	return Weak[T]{wp: weak.Make(p)}
+}
+
+// Ptr returns a strong pointer to the value, or nil if p was nil or the
+// value has been reclaimed. Holding the result keeps the value alive.
+func (w Weak[T]) Ptr() *T {
+	return w.wp.Value()
+}
+
+// Value returns a copy of the value and true if it is still alive.
+// Otherwise it returns the zero value of T and false.
+func (w Weak[T]) Value() (T, bool) {
+	return Value(w.Ptr())
+}
+
+// From returns a copy of the value, or the zero value of T if it has been
+// reclaimed. See the package-level From.
+func (w Weak[T]) From() T {
+	return From(w.Ptr())
+}
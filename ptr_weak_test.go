@@ -0,0 +1,53 @@
+//go:build go1.24
+
+package ptr
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestWeak(t *testing.T) {
+	type blob struct {
+		data [1024]byte
+		id   int
+	}
+
+	t.Run("alive", func(t *testing.T) {
+		p := &blob{id: 7}
+		w := MakeWeak(p)
+		runtime.GC()
+		if got := w.Ptr(); got != p {
+			t.Errorf("Ptr() = %p, want %p", got, p)
+		}
+		if v, ok := w.Value(); !ok || v.id != 7 {
+			t.Errorf("Value() = %d, %v; want 7, true", v.id, ok)
+		}
+		if v := w.From(); v.id != 7 {
+			t.Errorf("From() = %d, want 7", v.id)
+		}
+		runtime.KeepAlive(p)
+	})
+
+	t.Run("reclaimed", func(t *testing.T) {
+		w := MakeWeak(&blob{id: 7})
+		runtime.GC()
+		runtime.GC()
+		if w.Ptr() != nil {
+			t.Skip("value not reclaimed by this GC cycle")
+		}
+		if v, ok := w.Value(); ok || v.id != 0 {
+			t.Errorf("Value() = %d, %v; want 0, false", v.id, ok)
+		}
+	})
+
+	t.Run("nil and zero value", func(t *testing.T) {
+		if MakeWeak[int](nil).Ptr() != nil {
+			t.Error("expected nil for nil pointer")
+		}
+		var w Weak[int]
+		if _, ok := w.Value(); ok {
+			t.Error("expected zero Weak to have no value")
+		}
+	})
+}
@@ -0,0 +1,5 @@
+module go.companyinfo.dev/ptr/ptrcmp
+
+go 1.18
+
+require github.com/google/go-cmp v0.6.0
@@ -0,0 +1,48 @@
+// Package ptrcmp provides a go-cmp option that compares pointers by the
+// values they point to rather than by address.
+//
+// Example:
+//
+//	if diff := cmp.Diff(want, got, ptrcmp.Option()); diff != "" {
+//	    t.Errorf("user mismatch (-want +got):\n%s", diff)
+//	}
+package ptrcmp
+
+import (
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Option returns a cmp.Option that compares every pointer, including nested
+// pointer fields and elements, by its pointee: two nil pointers are equal, a
+// nil and a non-nil pointer differ, and two non-nil pointers are equal if
+// the values they point to are. Diffs show the differing values instead of
+// addresses.
+//
+// The option follows pointers without cycle detection, so it must not be
+// used on cyclic data structures.
+//
+// Example:
+//
+//	want := User{Name: ptr.String("Alice"), Age: ptr.Int(30)}
+//	got := User{Name: ptr.String("Alice"), Age: ptr.Int(31)}
+//	diff := cmp.Diff(want, got, ptrcmp.Option())
+//	// the diff reports Age: int(30) vs int(31)
+func Option() cmp.Option {
+	return cmp.FilterPath(isPointer, cmp.Transformer("ptr.Deref", deref))
+}
+
+func isPointer(p cmp.Path) bool {
+	t := p.Last().Type()
+	return t != nil && t.Kind() == reflect.Pointer
+}
+
+// deref returns the value p points to, or nil if p is a nil pointer.
+func deref(p any) any {
+	v := reflect.ValueOf(p)
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
@@ -0,0 +1,69 @@
+package ptrcmp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type address struct {
+	City *string
+}
+
+type user struct {
+	Name    *string
+	Age     *int
+	Tags    []*string
+	Address *address
+}
+
+func str(s string) *string { return &s }
+func num(n int) *int       { return &n }
+
+func TestOption(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  any
+		equal bool
+	}{
+		{"same value, different address", num(1), num(1), true},
+		{"different value", num(1), num(2), false},
+		{"both nil", (*int)(nil), (*int)(nil), true},
+		{"nil and non-nil", (*int)(nil), num(0), false},
+		{
+			"nested fields",
+			user{Name: str("a"), Tags: []*string{str("x"), nil}, Address: &address{City: str("c")}},
+			user{Name: str("a"), Tags: []*string{str("x"), nil}, Address: &address{City: str("c")}},
+			true,
+		},
+		{
+			"nested difference",
+			user{Address: &address{City: str("c")}},
+			user{Address: &address{City: str("d")}},
+			false,
+		},
+		{"pointer to pointer", func() **int { p := num(1); return &p }(), func() **int { p := num(1); return &p }(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmp.Equal(tt.a, tt.b, Option()); got != tt.equal {
+				t.Errorf("cmp.Equal() = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestOptionDiff(t *testing.T) {
+	want := user{Name: str("Alice"), Age: num(30)}
+	got := user{Name: str("Alice"), Age: num(31)}
+
+	diff := cmp.Diff(want, got, Option())
+	if !strings.Contains(diff, "int(30)") || !strings.Contains(diff, "int(31)") {
+		t.Errorf("expected diff to show the values, got:\n%s", diff)
+	}
+	if strings.Contains(diff, "0x") {
+		t.Errorf("expected diff without addresses, got:\n%s", diff)
+	}
+}
@@ -0,0 +1,5 @@
+module go.companyinfo.dev/ptr/ptrpb
+
+go 1.18
+
+require google.golang.org/protobuf v1.33.0
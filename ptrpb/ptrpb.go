@@ -0,0 +1,72 @@
+// Package ptrpb converts between optional Go time values and the protobuf
+// well-known types timestamppb.Timestamp and durationpb.Duration.
+//
+// Every function maps nil to nil in both directions.
+//
+// Example:
+//
+//	msg.DeletedAt = ptrpb.ToTimestamp(user.DeletedAt)
+//	user.DeletedAt = ptrpb.FromTimestamp(msg.DeletedAt)
+package ptrpb
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromTimestamp converts a protobuf Timestamp to a *time.Time in UTC.
+// Returns nil if ts is nil.
+//
+// Example:
+//
+//	deletedAt := ptrpb.FromTimestamp(msg.DeletedAt)  // nil if unset
+func FromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// ToTimestamp converts a *time.Time to a protobuf Timestamp.
+// Returns nil if t is nil.
+//
+// Example:
+//
+//	msg.DeletedAt = ptrpb.ToTimestamp(user.DeletedAt)
+func ToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// FromDuration converts a protobuf Duration to a *time.Duration.
+// Returns nil if d is nil. Out-of-range values saturate as in
+// durationpb.Duration.AsDuration.
+//
+// Example:
+//
+//	timeout := ptrpb.FromDuration(req.Timeout)  // nil if unset
+func FromDuration(d *durationpb.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	v := d.AsDuration()
+	return &v
+}
+
+// ToDuration converts a *time.Duration to a protobuf Duration.
+// Returns nil if d is nil.
+//
+// Example:
+//
+//	req.Timeout = ptrpb.ToDuration(cfg.Timeout)
+func ToDuration(d *time.Duration) *durationpb.Duration {
+	if d == nil {
+		return nil
+	}
+	return durationpb.New(*d)
+}
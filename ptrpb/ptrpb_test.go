@@ -0,0 +1,59 @@
+package ptrpb
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC)
+
+	ts := ToTimestamp(&now)
+	if ts == nil || ts.GetSeconds() != now.Unix() || ts.GetNanos() != int32(now.Nanosecond()) {
+		t.Fatalf("ToTimestamp() = %v, want %v", ts, now)
+	}
+	back := FromTimestamp(ts)
+	if back == nil || !back.Equal(now) {
+		t.Errorf("FromTimestamp() = %v, want %v", back, now)
+	}
+
+	local := now.In(time.FixedZone("UTC+2", 2*60*60))
+	if got := FromTimestamp(ToTimestamp(&local)); got == nil || !got.Equal(now) || got.Location() != time.UTC {
+		t.Errorf("expected round trip to return the same instant in UTC, got %v", got)
+	}
+
+	if ToTimestamp(nil) != nil {
+		t.Error("expected nil Timestamp for nil time")
+	}
+	if FromTimestamp(nil) != nil {
+		t.Error("expected nil time for nil Timestamp")
+	}
+	if got := FromTimestamp(&timestamppb.Timestamp{}); got == nil || !got.Equal(time.Unix(0, 0)) {
+		t.Errorf("expected Unix epoch for zero Timestamp, got %v", got)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	d := 90*time.Second + 5*time.Millisecond
+
+	pb := ToDuration(&d)
+	if pb == nil || pb.GetSeconds() != 90 || pb.GetNanos() != 5000000 {
+		t.Fatalf("ToDuration() = %v, want %v", pb, d)
+	}
+	if back := FromDuration(pb); back == nil || *back != d {
+		t.Errorf("FromDuration() = %v, want %v", back, d)
+	}
+
+	if ToDuration(nil) != nil {
+		t.Error("expected nil Duration for nil time.Duration")
+	}
+	if FromDuration(nil) != nil {
+		t.Error("expected nil time.Duration for nil Duration")
+	}
+	if got := FromDuration(&durationpb.Duration{}); got == nil || *got != 0 {
+		t.Errorf("expected 0 for zero Duration, got %v", got)
+	}
+}
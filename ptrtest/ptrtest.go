@@ -0,0 +1,98 @@
+// Package ptrtest provides test assertions for pointers that compare the
+// values they point to and report failures in terms of those values, such as
+// "want 42, got nil", instead of printing addresses.
+//
+// The assertions report failures with t.Errorf, so a test continues after a
+// failed check, and return whether the check passed.
+//
+// Example:
+//
+//	func TestLoadUser(t *testing.T) {
+//	    u := loadUser()
+//	    ptrtest.Equal(t, ptr.String("Alice"), u.Name)
+//	    ptrtest.Equal(t, nil, u.DeletedAt)
+//	}
+package ptrtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.companyinfo.dev/ptr"
+)
+
+// Equal checks that want and got are both nil, or both non-nil and point to
+// deeply equal values.
+//
+// Example:
+//
+//	ptrtest.Equal(t, ptr.Int(42), got)
+//	// on failure: "pointers differ: want 42, got nil"
+func Equal[T any](t testing.TB, want, got *T) bool {
+	t.Helper()
+	if ptr.DeepEqual(want, got) {
+		return true
+	}
+	t.Errorf("pointers differ: want %s, got %s", format(want), format(got))
+	return false
+}
+
+// NotNil checks that p is not nil.
+//
+// Example:
+//
+//	if ptrtest.NotNil(t, u.Email) {
+//	    // safe to dereference u.Email
+//	}
+func NotNil[T any](t testing.TB, p *T) bool {
+	t.Helper()
+	if p != nil {
+		return true
+	}
+	t.Errorf("want non-nil %T, got nil", p)
+	return false
+}
+
+// EqualSlices checks that want and got have the same length and that their
+// elements are pairwise equal as defined by Equal. Every differing index is
+// reported in a single failure message.
+//
+// Example:
+//
+//	ptrtest.EqualSlices(t, ptr.IntSlice([]int{1, 2}), got)
+//	// on failure: "slices differ:
+//	//     [1]: want 2, got nil"
+func EqualSlices[T any](t testing.TB, want, got []*T) bool {
+	t.Helper()
+	var diffs []string
+	if len(want) != len(got) {
+		diffs = append(diffs, fmt.Sprintf("length: want %d, got %d", len(want), len(got)))
+	}
+	for i := 0; i < len(want) || i < len(got); i++ {
+		switch {
+		case i >= len(got):
+			diffs = append(diffs, fmt.Sprintf("[%d]: want %s, got missing", i, format(want[i])))
+		case i >= len(want):
+			diffs = append(diffs, fmt.Sprintf("[%d]: want missing, got %s", i, format(got[i])))
+		case !ptr.DeepEqual(want[i], got[i]):
+			diffs = append(diffs, fmt.Sprintf("[%d]: want %s, got %s", i, format(want[i]), format(got[i])))
+		}
+	}
+	if len(diffs) == 0 {
+		return true
+	}
+	t.Errorf("slices differ:\n    %s", strings.Join(diffs, "\n    "))
+	return false
+}
+
+// format describes the value p points to, quoting strings.
+func format[T any](p *T) string {
+	if p == nil {
+		return "nil"
+	}
+	if s, ok := any(*p).(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", *p)
+}
@@ -0,0 +1,88 @@
+package ptrtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.companyinfo.dev/ptr"
+)
+
+// recorder captures failures reported through testing.TB.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name      string
+		want, got *int
+		msg       string
+	}{
+		{"equal values", ptr.Int(42), ptr.Int(42), ""},
+		{"both nil", nil, nil, ""},
+		{"want nil", nil, ptr.Int(42), "pointers differ: want nil, got 42"},
+		{"got nil", ptr.Int(42), nil, "pointers differ: want 42, got nil"},
+		{"different values", ptr.Int(42), ptr.Int(43), "pointers differ: want 42, got 43"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			ok := Equal(r, tt.want, tt.got)
+			if ok != (tt.msg == "") {
+				t.Errorf("Equal() = %v, want %v", ok, tt.msg == "")
+			}
+			if got := strings.Join(r.errors, "\n"); got != tt.msg {
+				t.Errorf("failure message = %q, want %q", got, tt.msg)
+			}
+		})
+	}
+
+	t.Run("quotes strings", func(t *testing.T) {
+		r := &recorder{}
+		Equal(r, ptr.String("a"), ptr.String(""))
+		if want := `pointers differ: want "a", got ""`; len(r.errors) != 1 || r.errors[0] != want {
+			t.Errorf("failure messages = %q, want %q", r.errors, want)
+		}
+	})
+}
+
+func TestNotNil(t *testing.T) {
+	r := &recorder{}
+	if !NotNil(r, ptr.Int(0)) || len(r.errors) != 0 {
+		t.Errorf("NotNil() failed for a non-nil pointer: %q", r.errors)
+	}
+	if NotNil[int](r, nil) {
+		t.Error("NotNil() = true for nil, want false")
+	}
+	if want := "want non-nil *int, got nil"; len(r.errors) != 1 || r.errors[0] != want {
+		t.Errorf("failure messages = %q, want %q", r.errors, want)
+	}
+}
+
+func TestEqualSlices(t *testing.T) {
+	r := &recorder{}
+	if !EqualSlices(r, []*int{ptr.Int(1), nil}, []*int{ptr.Int(1), nil}) || len(r.errors) != 0 {
+		t.Errorf("EqualSlices() failed for equal slices: %q", r.errors)
+	}
+
+	r = &recorder{}
+	if EqualSlices(r, []*int{ptr.Int(1), ptr.Int(2), ptr.Int(3)}, []*int{ptr.Int(1), nil}) {
+		t.Error("EqualSlices() = true for different slices, want false")
+	}
+	want := "slices differ:\n" +
+		"    length: want 3, got 2\n" +
+		"    [1]: want 2, got nil\n" +
+		"    [2]: want 3, got missing"
+	if len(r.errors) != 1 || r.errors[0] != want {
+		t.Errorf("failure messages = %q, want %q", r.errors, want)
+	}
+}